@@ -0,0 +1,90 @@
+package hyperliquid
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Action is the common shape every L1 action implements, so Do can sign,
+// post, and unmarshal it generically instead of each *WithContext method
+// duplicating the same nonce/build/sign/post/unmarshal sequence. Types in
+// the actions package satisfy this interface structurally and do not import
+// this package.
+type Action interface {
+	// Type returns the wire "type" discriminator, e.g. "spotDeployFreezeUser".
+	Type() string
+	// Payload returns the action's wire payload, ready to be signed and
+	// posted as-is.
+	Payload() map[string]any
+}
+
+// ActionFunc performs the sign-then-post step for a built action payload and
+// returns its raw response.
+type ActionFunc func(ctx context.Context, payload map[string]any, nonce int64) (json.RawMessage, error)
+
+// ActionMiddleware wraps an ActionFunc, letting callers attach logging,
+// metrics, retry, or dry-run behavior at a single place instead of inside
+// every action method. See Exchange.Use.
+type ActionMiddleware func(next ActionFunc) ActionFunc
+
+// Use appends middleware to e's action pipeline. Middleware wraps every
+// subsequent call to Do, in the order it was added here: the first
+// middleware added is outermost and sees the call before the rest.
+func (e *Exchange) Use(middleware ...ActionMiddleware) {
+	e.actionMiddleware = append(e.actionMiddleware, middleware...)
+}
+
+// actionChain builds the ActionFunc for one Do call, rooted at e's default
+// sign-with-configured-signer-then-postAction behavior and wrapped by any
+// middleware registered via Use.
+func (e *Exchange) actionChain() ActionFunc {
+	base := ActionFunc(func(ctx context.Context, payload map[string]any, nonce int64) (json.RawMessage, error) {
+		sig, err := SignL1ActionWithSigner(
+			ctx,
+			e.signerOrDefault(),
+			payload,
+			e.vault,
+			nonce,
+			e.expiresAfter,
+			e.client.baseURL == MainnetAPIURL,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return e.postAction(ctx, payload, sig, nonce)
+	})
+
+	for i := len(e.actionMiddleware) - 1; i >= 0; i-- {
+		base = e.actionMiddleware[i](base)
+	}
+	return base
+}
+
+// Do signs, posts, and unmarshals action using e's configured signer and
+// middleware chain into a *T, and is the single implementation every thin
+// *WithContext wrapper method in this chunk delegates to. T is the response
+// type, e.g. SpotDeployResponse.
+//
+// Do is a package-level generic function rather than a method because Go
+// does not allow type parameters on methods.
+func Do[T any](ctx context.Context, e *Exchange, action Action) (*T, error) {
+	result, _, err := DoWithNonce[T](ctx, e, action)
+	return result, err
+}
+
+// DoWithNonce is Do, but additionally returns the nonce the action was
+// submitted under, so a caller can feed it to Exchange.WaitForAction for
+// receipt confirmation afterward.
+func DoWithNonce[T any](ctx context.Context, e *Exchange, action Action) (*T, int64, error) {
+	nonce := e.nextNonce()
+	resp, err := e.actionChain()(ctx, action.Payload(), nonce)
+	if err != nil {
+		return nil, nonce, err
+	}
+
+	var result T
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, nonce, err
+	}
+	return &result, nonce, nil
+}
@@ -0,0 +1,80 @@
+// Package actions holds small typed structs for Hyperliquid L1 actions, one
+// per action type, so adding a new action is a ~10-line struct instead of a
+// ~50-line hand-rolled *WithContext method. Each type implements the
+// Type()/Payload() methods that satisfy the root module's Action interface
+// by structural typing; this package deliberately does not import the root
+// module, so it stays usable standalone (e.g. to build a payload for
+// offline signing) without pulling in the rest of the SDK.
+package actions
+
+// SpotDeployFreezeUser freezes a user in spot trading.
+type SpotDeployFreezeUser struct {
+	UserAddress string
+}
+
+func (a SpotDeployFreezeUser) Type() string { return "spotDeployFreezeUser" }
+
+func (a SpotDeployFreezeUser) Payload() map[string]any {
+	return map[string]any{
+		"type":        a.Type(),
+		"userAddress": a.UserAddress,
+	}
+}
+
+// SpotDeployGenesis initializes spot genesis for a deployer's dex.
+type SpotDeployGenesis struct {
+	Deployer string
+	DexName  string
+}
+
+func (a SpotDeployGenesis) Type() string { return "spotDeployGenesis" }
+
+func (a SpotDeployGenesis) Payload() map[string]any {
+	return map[string]any{
+		"type":     a.Type(),
+		"deployer": a.Deployer,
+		"dexName":  a.DexName,
+	}
+}
+
+// PerpDeployRegisterAsset registers a new perpetual asset. PerpDexInput is
+// any rather than the root module's typed PerpDexSchemaInput, since this
+// package does not import the root module; pass the same value you would
+// have passed to Exchange.PerpDeployRegisterAsset.
+type PerpDeployRegisterAsset struct {
+	Asset        string
+	PerpDexInput any
+}
+
+func (a PerpDeployRegisterAsset) Type() string { return "perpDeployRegisterAsset" }
+
+func (a PerpDeployRegisterAsset) Payload() map[string]any {
+	return map[string]any{
+		"type":         a.Type(),
+		"asset":        a.Asset,
+		"perpDexInput": a.PerpDexInput,
+	}
+}
+
+// CSignerJailSelf jails the caller as consensus signer.
+type CSignerJailSelf struct{}
+
+func (a CSignerJailSelf) Type() string { return "cSignerJailSelf" }
+
+func (a CSignerJailSelf) Payload() map[string]any {
+	return map[string]any{"type": a.Type()}
+}
+
+// CValidatorRegister registers the caller as a consensus validator.
+type CValidatorRegister struct {
+	ValidatorProfile map[string]any
+}
+
+func (a CValidatorRegister) Type() string { return "cValidatorRegister" }
+
+func (a CValidatorRegister) Payload() map[string]any {
+	return map[string]any{
+		"type":             a.Type(),
+		"validatorProfile": a.ValidatorProfile,
+	}
+}
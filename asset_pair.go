@@ -0,0 +1,144 @@
+package hyperliquid
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Sentinel errors TokenID.Validate and AssetPair.Validate return, so
+// callers can errors.Is instead of matching message text.
+var (
+	// ErrTokenNameEmpty is returned when a TokenID has no Name.
+	ErrTokenNameEmpty = errors.New("hyperliquid: token name must not be empty")
+
+	// ErrTokenIndexNegative is returned when a TokenID's Index is negative.
+	ErrTokenIndexNegative = errors.New("hyperliquid: token index must not be negative")
+
+	// ErrTokenDecimalsOutOfRange is returned when WeiDecimals or
+	// SzDecimals falls outside Hyperliquid's supported range (0 to
+	// maxTokenDecimals).
+	ErrTokenDecimalsOutOfRange = errors.New("hyperliquid: token decimals out of range")
+
+	// ErrAssetPairSameToken is returned when an AssetPair's Base and Quote
+	// are the same token.
+	ErrAssetPairSameToken = errors.New("hyperliquid: asset pair base and quote must be distinct")
+
+	// ErrAssetPairQuoteNotCanonical is returned when an AssetPair's Quote
+	// is not a canonical (stable) token, which Hyperliquid requires for
+	// every spot pair.
+	ErrAssetPairQuoteNotCanonical = errors.New("hyperliquid: asset pair quote must be a canonical token")
+)
+
+// maxTokenDecimals bounds TokenID.WeiDecimals/SzDecimals. Hyperliquid spot
+// tokens never exceed 18 decimals (EVM's own ceiling for ERC-20 wei
+// amounts), well above decimalScale's 8, which only bounds Decimal's wire
+// precision, not a token's on-chain decimals.
+const maxTokenDecimals = 18
+
+// Validate reports whether t is well-formed: Name set, Index non-negative,
+// and WeiDecimals/SzDecimals within Hyperliquid's supported range. A
+// TokenID built from Fill/SpotBalance data (WeiDecimals and SzDecimals left
+// zero) still validates, since zero is a valid decimals count.
+func (t TokenID) Validate() error {
+	if t.Name == "" {
+		return ErrTokenNameEmpty
+	}
+	if t.Index < 0 {
+		return ErrTokenIndexNegative
+	}
+	if t.WeiDecimals < 0 || t.WeiDecimals > maxTokenDecimals {
+		return fmt.Errorf("%w: WeiDecimals %d", ErrTokenDecimalsOutOfRange, t.WeiDecimals)
+	}
+	if t.SzDecimals < 0 || t.SzDecimals > maxTokenDecimals {
+		return fmt.Errorf("%w: SzDecimals %d", ErrTokenDecimalsOutOfRange, t.SzDecimals)
+	}
+	return nil
+}
+
+// AssetPair is a spot trading pair, e.g. PURR/USDC, as two TokenIDs rather
+// than the wire format's raw [base_index, quote_index] pair. It
+// round-trips through JSON in both the wire's index-pair form and a
+// human-readable "BASE/QUOTE" string form; see MarshalJSON/UnmarshalJSON.
+type AssetPair struct {
+	Base  TokenID
+	Quote TokenID
+}
+
+// String formats p as "BASE/QUOTE", Hyperliquid's own spot pair naming
+// convention (e.g. "PURR/USDC").
+func (p AssetPair) String() string {
+	return p.Base.Name + "/" + p.Quote.Name
+}
+
+// Validate reports whether p is well-formed: both tokens individually
+// valid, Base and Quote distinct, and Quote canonical (Hyperliquid requires
+// every spot pair's quote leg to be a canonical stable token).
+func (p AssetPair) Validate() error {
+	if err := p.Base.Validate(); err != nil {
+		return fmt.Errorf("base: %w", err)
+	}
+	if err := p.Quote.Validate(); err != nil {
+		return fmt.Errorf("quote: %w", err)
+	}
+	if p.Base.Index == p.Quote.Index && p.Base.Name == p.Quote.Name {
+		return ErrAssetPairSameToken
+	}
+	if !p.Quote.IsCanonical {
+		return ErrAssetPairQuoteNotCanonical
+	}
+	return nil
+}
+
+// MarshalJSON encodes p as the wire's [base_index, quote_index] pair,
+// matching the shape SpotMeta's Universe entries use for their Tokens
+// field, when p's indices have been resolved. A pair built from
+// UnmarshalJSON's "BASE/QUOTE" string branch has no resolved indices
+// (Base.Index and Quote.Index are both left at the zero value, which no
+// two distinct real tokens both share), so it falls back to the
+// "BASE/QUOTE" string form instead of silently losing the names to an
+// indistinguishable [0, 0].
+func (p AssetPair) MarshalJSON() ([]byte, error) {
+	if p.Base.Index == 0 && p.Quote.Index == 0 && p.Base.Name != "" && p.Quote.Name != "" {
+		return json.Marshal(p.String())
+	}
+	return json.Marshal([2]int{p.Base.Index, p.Quote.Index})
+}
+
+// UnmarshalJSON accepts either the wire's [base_index, quote_index] pair or
+// a "BASE/QUOTE" string. The index-pair form only populates Base.Index/
+// Quote.Index; callers needing names and decimals must resolve those
+// separately (e.g. against a cached SpotMeta), the same limitation the raw
+// []int wire form already has.
+func (p *AssetPair) UnmarshalJSON(data []byte) error {
+	var indexPair [2]int
+	if err := json.Unmarshal(data, &indexPair); err == nil {
+		p.Base = TokenID{Index: indexPair[0]}
+		p.Quote = TokenID{Index: indexPair[1]}
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("hyperliquid: asset pair must be a [base_index, quote_index] pair or \"BASE/QUOTE\" string: %w", err)
+	}
+
+	base, quote, ok := splitAssetPairString(s)
+	if !ok {
+		return fmt.Errorf("hyperliquid: invalid asset pair string %q, want \"BASE/QUOTE\"", s)
+	}
+	p.Base = TokenID{Name: base}
+	p.Quote = TokenID{Name: quote}
+	return nil
+}
+
+// splitAssetPairString splits "BASE/QUOTE" into its two legs, reporting
+// false if s does not have exactly one "/" or either leg is empty.
+func splitAssetPairString(s string) (base, quote string, ok bool) {
+	base, quote, found := strings.Cut(s, "/")
+	if !found || base == "" || quote == "" || strings.Contains(quote, "/") {
+		return "", "", false
+	}
+	return base, quote, true
+}
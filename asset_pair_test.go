@@ -0,0 +1,134 @@
+package hyperliquid
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenIDValidate(t *testing.T) {
+	cases := []struct {
+		name    string
+		token   TokenID
+		wantErr error
+	}{
+		{
+			name:  "valid",
+			token: TokenID{Name: "USDC", Index: 0, WeiDecimals: 8, SzDecimals: 8, IsCanonical: true},
+		},
+		{
+			name:    "empty name",
+			token:   TokenID{Name: "", Index: 0},
+			wantErr: ErrTokenNameEmpty,
+		},
+		{
+			name:    "negative index",
+			token:   TokenID{Name: "HYPE", Index: -1},
+			wantErr: ErrTokenIndexNegative,
+		},
+		{
+			name:    "wei decimals out of range",
+			token:   TokenID{Name: "HYPE", Index: 1105, WeiDecimals: 19},
+			wantErr: ErrTokenDecimalsOutOfRange,
+		},
+		{
+			name:    "sz decimals negative",
+			token:   TokenID{Name: "HYPE", Index: 1105, SzDecimals: -1},
+			wantErr: ErrTokenDecimalsOutOfRange,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.token.Validate()
+			if tc.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.True(t, errors.Is(err, tc.wantErr))
+		})
+	}
+}
+
+func TestAssetPairValidate(t *testing.T) {
+	usdc := TokenID{Name: "USDC", Index: 0, IsCanonical: true}
+	hype := TokenID{Name: "HYPE", Index: 1105}
+
+	cases := []struct {
+		name    string
+		pair    AssetPair
+		wantErr error
+	}{
+		{
+			name: "valid",
+			pair: AssetPair{Base: hype, Quote: usdc},
+		},
+		{
+			name:    "same token both legs",
+			pair:    AssetPair{Base: usdc, Quote: usdc},
+			wantErr: ErrAssetPairSameToken,
+		},
+		{
+			name:    "quote not canonical",
+			pair:    AssetPair{Base: usdc, Quote: hype},
+			wantErr: ErrAssetPairQuoteNotCanonical,
+		},
+		{
+			name:    "invalid base",
+			pair:    AssetPair{Base: TokenID{Name: ""}, Quote: usdc},
+			wantErr: ErrTokenNameEmpty,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.pair.Validate()
+			if tc.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.True(t, errors.Is(err, tc.wantErr))
+		})
+	}
+}
+
+func TestAssetPairString(t *testing.T) {
+	pair := AssetPair{Base: TokenID{Name: "PURR"}, Quote: TokenID{Name: "USDC"}}
+	assert.Equal(t, "PURR/USDC", pair.String())
+}
+
+func TestAssetPairMarshalJSONUsesIndexPairWireForm(t *testing.T) {
+	pair := AssetPair{Base: TokenID{Name: "HYPE", Index: 1105}, Quote: TokenID{Name: "USDC", Index: 0}}
+
+	data, err := json.Marshal(pair)
+	require.NoError(t, err)
+	assert.JSONEq(t, "[1105, 0]", string(data))
+}
+
+func TestAssetPairMarshalJSONFallsBackToStringWhenIndicesUnresolved(t *testing.T) {
+	pair := AssetPair{Base: TokenID{Name: "PURR"}, Quote: TokenID{Name: "USDC"}}
+
+	data, err := json.Marshal(pair)
+	require.NoError(t, err)
+	assert.JSONEq(t, `"PURR/USDC"`, string(data))
+
+	var roundTripped AssetPair
+	require.NoError(t, json.Unmarshal(data, &roundTripped))
+	assert.Equal(t, pair, roundTripped)
+}
+
+func TestAssetPairUnmarshalJSONAcceptsIndexPairAndString(t *testing.T) {
+	var fromIndexPair AssetPair
+	require.NoError(t, json.Unmarshal([]byte("[1105, 0]"), &fromIndexPair))
+	assert.Equal(t, AssetPair{Base: TokenID{Index: 1105}, Quote: TokenID{Index: 0}}, fromIndexPair)
+
+	var fromString AssetPair
+	require.NoError(t, json.Unmarshal([]byte(`"PURR/USDC"`), &fromString))
+	assert.Equal(t, AssetPair{Base: TokenID{Name: "PURR"}, Quote: TokenID{Name: "USDC"}}, fromString)
+
+	var invalid AssetPair
+	assert.Error(t, json.Unmarshal([]byte(`"PURR-USDC"`), &invalid))
+}
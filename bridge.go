@@ -0,0 +1,548 @@
+package hyperliquid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"sync"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// BridgeProviderKind names a downstream bridge BridgeRouter can route
+// through once USDC has landed on Arbitrum.
+type BridgeProviderKind string
+
+const (
+	HopBridge    BridgeProviderKind = "hop"
+	AcrossBridge BridgeProviderKind = "across"
+)
+
+// BridgeOpts configures one WithdrawToChain call.
+type BridgeOpts struct {
+	// Provider selects the downstream bridge BridgeRouter hands the
+	// Arbitrum-side call to.
+	Provider BridgeProviderKind
+	// SlippageBps bounds the amount the bridge is allowed to take as a
+	// relay/LP fee, in basis points.
+	SlippageBps int
+}
+
+// BridgeRequest is everything a BridgeProvider needs to build the
+// Arbitrum-side contract call that forwards funds to their final
+// destination chain.
+type BridgeRequest struct {
+	AmountWei   *big.Int
+	DestChainID uint64
+	DestAddress common.Address
+	SenderAddr  common.Address
+	SlippageBps int
+}
+
+// BridgeProvider builds the calldata for one downstream bridge's
+// Arbitrum-side entry point. It does not send the transaction itself:
+// BridgeRouter owns nonce/gas management on the caller-supplied
+// ethclient.Client so every provider is signed and broadcast the same way.
+type BridgeProvider interface {
+	// Kind identifies the provider, stored on the WithdrawalTicket so a
+	// resumed withdrawal knows which provider to rebuild the call with.
+	Kind() BridgeProviderKind
+	// BuildCall returns the contract address, calldata, and any ETH value
+	// (for relayer fees) needed to bridge req.AmountWei to its destination.
+	BuildCall(ctx context.Context, req BridgeRequest) (to common.Address, data []byte, value *big.Int, err error)
+}
+
+// WithdrawalStage is a step in the WithdrawToChain state machine. Stages
+// only move forward; ResumeWithdrawal picks up from the last one recorded
+// on the ticket.
+type WithdrawalStage string
+
+const (
+	// StageInitiated: withdraw3 was submitted to Hyperliquid but USDC has
+	// not yet been confirmed to have landed on Arbitrum.
+	StageInitiated WithdrawalStage = "initiated"
+	// StageArbitrumConfirmed: the caller-supplied ethclient.Client has
+	// observed the expected USDC balance on Arbitrum.
+	StageArbitrumConfirmed WithdrawalStage = "arbitrum_confirmed"
+	// StageBridgeSent: the downstream bridge transaction has been
+	// broadcast on Arbitrum.
+	StageBridgeSent WithdrawalStage = "bridge_sent"
+	// StageComplete: the bridge transaction has been mined.
+	StageComplete WithdrawalStage = "complete"
+)
+
+// WithdrawalTicket tracks one WithdrawToChain call across process
+// restarts, so a crash between Hyperliquid withdrawal and the downstream
+// bridge call does not strand funds on Arbitrum.
+type WithdrawalTicket struct {
+	ID          string
+	Stage       WithdrawalStage
+	Provider    BridgeProviderKind
+	AmountWei   string
+	DestChainID uint64
+	DestAddress string
+	SlippageBps int
+
+	// BaselineArbitrumBalanceWei is the account's Arbitrum USDC balance
+	// snapshotted right before this withdrawal's withdraw3 was submitted.
+	// waitForArbitrumBalance polls for the balance to reach
+	// BaselineArbitrumBalanceWei + AmountWei rather than just >= AmountWei,
+	// so a balance the account already held from unrelated funds (or from
+	// another ticket racing the same account) can't be mistaken for this
+	// withdrawal having landed.
+	BaselineArbitrumBalanceWei string
+
+	ArbitrumTxHash string
+	BridgeTxHash   string
+
+	CreatedAtUnixMilli int64
+	UpdatedAtUnixMilli int64
+}
+
+// TicketStore persists WithdrawalTicket state so ResumeWithdrawal works
+// after a process restart. It mirrors NonceStore's Load/Save shape.
+type TicketStore interface {
+	Load(id string) (*WithdrawalTicket, error)
+	Save(ticket *WithdrawalTicket) error
+}
+
+// MemoryTicketStore is the default in-memory TicketStore. Tickets do not
+// survive process restarts.
+type MemoryTicketStore struct {
+	mu      sync.Mutex
+	tickets map[string]*WithdrawalTicket
+}
+
+// NewMemoryTicketStore creates an empty in-memory TicketStore.
+func NewMemoryTicketStore() *MemoryTicketStore {
+	return &MemoryTicketStore{tickets: make(map[string]*WithdrawalTicket)}
+}
+
+func (s *MemoryTicketStore) Load(id string) (*WithdrawalTicket, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ticket, ok := s.tickets[id]
+	if !ok {
+		return nil, fmt.Errorf("no withdrawal ticket found for id %s", id)
+	}
+	copied := *ticket
+	return &copied, nil
+}
+
+func (s *MemoryTicketStore) Save(ticket *WithdrawalTicket) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	copied := *ticket
+	s.tickets[ticket.ID] = &copied
+	return nil
+}
+
+// FileTicketStore persists tickets to a JSON file on disk, one entry per
+// ticket ID, so an in-flight withdrawal survives process restarts.
+type FileTicketStore struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewFileTicketStore creates a FileTicketStore backed by path. The file is
+// created lazily on the first Save.
+func NewFileTicketStore(path string) *FileTicketStore {
+	return &FileTicketStore{path: path}
+}
+
+func (s *FileTicketStore) Load(id string) (*WithdrawalTicket, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tickets, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+	ticket, ok := tickets[id]
+	if !ok {
+		return nil, fmt.Errorf("no withdrawal ticket found for id %s", id)
+	}
+	return ticket, nil
+}
+
+func (s *FileTicketStore) Save(ticket *WithdrawalTicket) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tickets, err := s.read()
+	if err != nil {
+		return err
+	}
+	tickets[ticket.ID] = ticket
+
+	data, err := json.Marshal(tickets)
+	if err != nil {
+		return fmt.Errorf("failed to marshal ticket store: %w", err)
+	}
+	if err := os.WriteFile(s.path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write ticket store: %w", err)
+	}
+	return nil
+}
+
+func (s *FileTicketStore) read() (map[string]*WithdrawalTicket, error) {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return make(map[string]*WithdrawalTicket), nil
+		}
+		return nil, fmt.Errorf("failed to read ticket store: %w", err)
+	}
+
+	tickets := make(map[string]*WithdrawalTicket)
+	if len(data) == 0 {
+		return tickets, nil
+	}
+	if err := json.Unmarshal(data, &tickets); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal ticket store: %w", err)
+	}
+	return tickets, nil
+}
+
+// BridgeRouter composes Exchange.WithdrawFromBridgeWithContext with a
+// downstream BridgeProvider to deliver USDC to an arbitrary destination
+// chain, resuming from a TicketStore if the process crashes mid-flight.
+type BridgeRouter struct {
+	exchange  *Exchange
+	ethClient *ethclient.Client
+	providers map[BridgeProviderKind]BridgeProvider
+	store     TicketStore
+
+	// confirmPollInterval controls how often WithdrawToChain polls
+	// ethClient for the Arbitrum-side USDC balance before moving to
+	// StageArbitrumConfirmed.
+	confirmPollInterval time.Duration
+	confirmTimeout      time.Duration
+
+	// usdcAddress is the Arbitrum USDC contract BridgeRouter checks the
+	// balance of before invoking the downstream bridge.
+	usdcAddress common.Address
+}
+
+// NewBridgeRouter creates a BridgeRouter for exchange that settles bridged
+// withdrawals on ethClient (an Arbitrum RPC endpoint), persisting
+// in-flight tickets to store. A nil store defaults to an in-memory
+// MemoryTicketStore.
+func NewBridgeRouter(
+	exchange *Exchange,
+	ethClient *ethclient.Client,
+	usdcAddress common.Address,
+	store TicketStore,
+	providers ...BridgeProvider,
+) *BridgeRouter {
+	if store == nil {
+		store = NewMemoryTicketStore()
+	}
+
+	registered := make(map[BridgeProviderKind]BridgeProvider, len(providers))
+	for _, p := range providers {
+		registered[p.Kind()] = p
+	}
+
+	return &BridgeRouter{
+		exchange:            exchange,
+		ethClient:           ethClient,
+		providers:           registered,
+		store:               store,
+		confirmPollInterval: 3 * time.Second,
+		confirmTimeout:      2 * time.Minute,
+		usdcAddress:         usdcAddress,
+	}
+}
+
+// WithdrawToChain withdraws amount USDC from Hyperliquid to Arbitrum via
+// WithdrawFromBridgeWithContext, waits for it to land, then forwards it to
+// destAddress on destChainID through opts.Provider. Once the Hyperliquid
+// withdrawal is accepted and the ticket persisted, it drives the ticket
+// through every remaining stage before returning, and on a failure in a
+// later stage returns the ticket at its last persisted stage alongside the
+// error; ResumeWithdrawal(ticket.ID) continues the flow from there, or
+// after the process dies before StageComplete.
+func (r *BridgeRouter) WithdrawToChain(
+	ctx context.Context,
+	amount float64,
+	destChainID uint64,
+	destAddress common.Address,
+	opts BridgeOpts,
+) (*WithdrawalTicket, error) {
+	provider, ok := r.providers[opts.Provider]
+	if !ok {
+		return nil, fmt.Errorf("bridge provider %q is not registered on this router", opts.Provider)
+	}
+
+	baseline, err := r.usdcBalanceOf(ctx, common.HexToAddress(r.exchange.accountAddr))
+	if err != nil {
+		return nil, fmt.Errorf("snapshot arbitrum usdc balance: %w", err)
+	}
+
+	resp, err := r.exchange.WithdrawFromBridgeWithContext(ctx, amount, r.exchange.accountAddr)
+	if err != nil {
+		return nil, fmt.Errorf("withdraw from hyperliquid bridge: %w", err)
+	}
+	if resp.Status != "ok" {
+		return nil, fmt.Errorf("hyperliquid rejected withdrawal: %s", resp.Response)
+	}
+
+	now := time.Now().UnixMilli()
+	ticket := &WithdrawalTicket{
+		ID:                         fmt.Sprintf("%s-%d", r.exchange.accountAddr, now),
+		Stage:                      StageInitiated,
+		Provider:                   opts.Provider,
+		AmountWei:                  usdcToWei(amount).String(),
+		DestChainID:                destChainID,
+		DestAddress:                destAddress.Hex(),
+		SlippageBps:                opts.SlippageBps,
+		BaselineArbitrumBalanceWei: baseline.String(),
+		CreatedAtUnixMilli:         now,
+		UpdatedAtUnixMilli:         now,
+	}
+	if err := r.store.Save(ticket); err != nil {
+		return nil, fmt.Errorf("persist withdrawal ticket: %w", err)
+	}
+
+	return r.advance(ctx, ticket, provider)
+}
+
+// ResumeWithdrawal continues a WithdrawalTicket from whatever stage it was
+// last persisted at, e.g. after a process restart interrupted
+// WithdrawToChain between the Hyperliquid withdrawal and the downstream
+// bridge call.
+func (r *BridgeRouter) ResumeWithdrawal(ctx context.Context, ticketID string) (*WithdrawalTicket, error) {
+	ticket, err := r.store.Load(ticketID)
+	if err != nil {
+		return nil, fmt.Errorf("load withdrawal ticket: %w", err)
+	}
+
+	provider, ok := r.providers[ticket.Provider]
+	if !ok {
+		return nil, fmt.Errorf("bridge provider %q is not registered on this router", ticket.Provider)
+	}
+
+	return r.advance(ctx, ticket, provider)
+}
+
+// advance drives ticket forward through its remaining stages.
+func (r *BridgeRouter) advance(ctx context.Context, ticket *WithdrawalTicket, provider BridgeProvider) (*WithdrawalTicket, error) {
+	amountWei, ok := new(big.Int).SetString(ticket.AmountWei, 10)
+	if !ok {
+		return nil, fmt.Errorf("corrupt ticket %s: invalid amountWei %q", ticket.ID, ticket.AmountWei)
+	}
+	baselineWei, ok := new(big.Int).SetString(ticket.BaselineArbitrumBalanceWei, 10)
+	if !ok {
+		return nil, fmt.Errorf("corrupt ticket %s: invalid baselineArbitrumBalanceWei %q", ticket.ID, ticket.BaselineArbitrumBalanceWei)
+	}
+
+	if ticket.Stage == StageInitiated {
+		if err := r.waitForArbitrumBalance(ctx, baselineWei, amountWei); err != nil {
+			return ticket, fmt.Errorf("wait for arbitrum confirmation: %w", err)
+		}
+		ticket.Stage = StageArbitrumConfirmed
+		if err := r.store.Save(ticket); err != nil {
+			return ticket, fmt.Errorf("persist ticket after arbitrum confirmation: %w", err)
+		}
+	}
+
+	if ticket.Stage == StageArbitrumConfirmed {
+		txHash, err := r.sendBridgeTx(ctx, provider, ticket, amountWei)
+		if err != nil {
+			return ticket, fmt.Errorf("send bridge transaction: %w", err)
+		}
+		ticket.BridgeTxHash = txHash
+		ticket.Stage = StageBridgeSent
+		if err := r.store.Save(ticket); err != nil {
+			return ticket, fmt.Errorf("persist ticket after bridge send: %w", err)
+		}
+	}
+
+	if ticket.Stage == StageBridgeSent {
+		if err := r.waitMined(ctx, common.HexToHash(ticket.BridgeTxHash)); err != nil {
+			return ticket, fmt.Errorf("wait for bridge transaction to mine: %w", err)
+		}
+		ticket.Stage = StageComplete
+		if err := r.store.Save(ticket); err != nil {
+			return ticket, fmt.Errorf("persist ticket after completion: %w", err)
+		}
+	}
+
+	return ticket, nil
+}
+
+// waitForArbitrumBalance polls until the account's Arbitrum USDC balance
+// reaches baseline+amountWei, not merely amountWei: checking amountWei alone
+// would let a balance the account already held from unrelated funds (or
+// from another ticket racing the same account) be mistaken for this
+// withdrawal having landed, letting advance bridge out funds before
+// withdraw3 actually lands or double-spending one pool across tickets.
+func (r *BridgeRouter) waitForArbitrumBalance(ctx context.Context, baseline, amountWei *big.Int) error {
+	deadline := time.Now().Add(r.confirmTimeout)
+	target := new(big.Int).Add(baseline, amountWei)
+
+	for {
+		balance, err := r.usdcBalanceOf(ctx, common.HexToAddress(r.exchange.accountAddr))
+		if err == nil && balance.Cmp(target) >= 0 {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for arbitrum USDC balance")
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(r.confirmPollInterval):
+		}
+	}
+}
+
+// erc20BalanceOfSelector is the 4-byte selector for balanceOf(address).
+var erc20BalanceOfSelector = crypto.Keccak256([]byte("balanceOf(address)"))[:4]
+
+// usdcBalanceOf reads balanceOf(owner) on the Arbitrum USDC contract via
+// the raw ERC-20 selector, avoiding a dependency on generated bindings.
+func (r *BridgeRouter) usdcBalanceOf(ctx context.Context, owner common.Address) (*big.Int, error) {
+	data := append(append([]byte{}, erc20BalanceOfSelector...), common.LeftPadBytes(owner.Bytes(), 32)...)
+
+	result, err := r.ethClient.CallContract(ctx, ethereum.CallMsg{
+		To:   &r.usdcAddress,
+		Data: data,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(result), nil
+}
+
+func (r *BridgeRouter) sendBridgeTx(
+	ctx context.Context,
+	provider BridgeProvider,
+	ticket *WithdrawalTicket,
+	amountWei *big.Int,
+) (string, error) {
+	to, data, value, err := provider.BuildCall(ctx, BridgeRequest{
+		AmountWei:   amountWei,
+		DestChainID: ticket.DestChainID,
+		DestAddress: common.HexToAddress(ticket.DestAddress),
+		SenderAddr:  common.HexToAddress(r.exchange.accountAddr),
+		SlippageBps: ticket.SlippageBps,
+	})
+	if err != nil {
+		return "", fmt.Errorf("build %s call: %w", provider.Kind(), err)
+	}
+
+	tx, err := r.signAndSend(ctx, to, data, value)
+	if err != nil {
+		return "", err
+	}
+	return tx.Hash().Hex(), nil
+}
+
+// signAndSend is the single place BridgeRouter talks to the chain to
+// broadcast a transaction, using e's configured Signer so a Ledger or
+// remote KMS key can authorize the Arbitrum-side bridge call the same way
+// it authorizes Hyperliquid actions.
+func (r *BridgeRouter) signAndSend(
+	ctx context.Context,
+	to common.Address,
+	data []byte,
+	value *big.Int,
+) (*types.Transaction, error) {
+	signer := r.exchange.signerOrDefault()
+
+	chainID, err := r.ethClient.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetch chain id: %w", err)
+	}
+
+	nonce, err := r.ethClient.PendingNonceAt(ctx, signer.Address())
+	if err != nil {
+		return nil, fmt.Errorf("fetch arbitrum nonce: %w", err)
+	}
+
+	gasTipCap, err := r.ethClient.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("suggest gas tip cap: %w", err)
+	}
+	gasFeeCap, err := r.ethClient.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("suggest gas price: %w", err)
+	}
+
+	unsigned := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     nonce,
+		GasTipCap: gasTipCap,
+		GasFeeCap: gasFeeCap,
+		Gas:       defaultBridgeGasLimit,
+		To:        &to,
+		Value:     value,
+		Data:      data,
+	})
+
+	hash := types.NewLondonSigner(chainID).Hash(unsigned)
+	sig, err := signer.SignHash(ctx, hash[:])
+	if err != nil {
+		return nil, fmt.Errorf("sign bridge transaction: %w", err)
+	}
+
+	rawSig, err := signatureResultToBytes(sig)
+	if err != nil {
+		return nil, fmt.Errorf("encode bridge signature: %w", err)
+	}
+
+	signed, err := unsigned.WithSignature(types.NewLondonSigner(chainID), rawSig)
+	if err != nil {
+		return nil, fmt.Errorf("attach signature to bridge transaction: %w", err)
+	}
+
+	if err := r.ethClient.SendTransaction(ctx, signed); err != nil {
+		return nil, fmt.Errorf("broadcast bridge transaction: %w", err)
+	}
+	return signed, nil
+}
+
+func (r *BridgeRouter) waitMined(ctx context.Context, hash common.Hash) error {
+	deadline := time.Now().Add(r.confirmTimeout)
+
+	for {
+		receipt, err := r.ethClient.TransactionReceipt(ctx, hash)
+		if err == nil && receipt != nil {
+			if receipt.Status == types.ReceiptStatusSuccessful {
+				return nil
+			}
+			return fmt.Errorf("bridge transaction %s reverted", hash.Hex())
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for bridge transaction %s to mine", hash.Hex())
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(r.confirmPollInterval):
+		}
+	}
+}
+
+// defaultBridgeGasLimit is a conservative gas limit for the bridge
+// contract calls this router issues (sendToL2 / swapAndSend / SpokePool
+// deposit are all well under this on Arbitrum).
+const defaultBridgeGasLimit = 500_000
+
+func usdcToWei(amount float64) *big.Int {
+	// USDC has 6 decimals.
+	scaled := big.NewFloat(amount * 1_000_000)
+	wei, _ := scaled.Int(nil)
+	return wei
+}
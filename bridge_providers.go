@@ -0,0 +1,148 @@
+package hyperliquid
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// hopL2AmmWrapperABI is the minimal ABI fragment for Hop's L2_AmmWrapper
+// swapAndSend entry point, the one relevant call for forwarding funds that
+// already landed on Arbitrum out to another L2/L1.
+const hopL2AmmWrapperABI = `[{
+	"name": "swapAndSend",
+	"type": "function",
+	"inputs": [
+		{"name": "chainId", "type": "uint256"},
+		{"name": "recipient", "type": "address"},
+		{"name": "amount", "type": "uint256"},
+		{"name": "bonderFee", "type": "uint256"},
+		{"name": "amountOutMin", "type": "uint256"},
+		{"name": "deadline", "type": "uint256"},
+		{"name": "destinationAmountOutMin", "type": "uint256"},
+		{"name": "destinationDeadline", "type": "uint256"}
+	]
+}]`
+
+// HopL2AmmWrapper implements BridgeProvider on top of Hop Protocol's
+// L2_AmmWrapper contract, deployed per-token on each supported chain.
+type HopL2AmmWrapper struct {
+	contractAddr common.Address
+	abi          abi.ABI
+	deadlineTTL  time.Duration
+}
+
+// NewHopL2AmmWrapper creates a HopL2AmmWrapper for the USDC
+// L2_AmmWrapper deployed at contractAddr on Arbitrum.
+func NewHopL2AmmWrapper(contractAddr common.Address) (*HopL2AmmWrapper, error) {
+	parsed, err := abi.JSON(strings.NewReader(hopL2AmmWrapperABI))
+	if err != nil {
+		return nil, fmt.Errorf("parse hop L2_AmmWrapper ABI: %w", err)
+	}
+	return &HopL2AmmWrapper{contractAddr: contractAddr, abi: parsed, deadlineTTL: 30 * time.Minute}, nil
+}
+
+func (h *HopL2AmmWrapper) Kind() BridgeProviderKind {
+	return HopBridge
+}
+
+func (h *HopL2AmmWrapper) BuildCall(
+	_ context.Context,
+	req BridgeRequest,
+) (common.Address, []byte, *big.Int, error) {
+	minOut := applySlippage(req.AmountWei, req.SlippageBps)
+	deadline := big.NewInt(time.Now().Add(h.deadlineTTL).Unix())
+
+	data, err := h.abi.Pack(
+		"swapAndSend",
+		new(big.Int).SetUint64(req.DestChainID),
+		req.DestAddress,
+		req.AmountWei,
+		big.NewInt(0), // bonderFee: left to the caller's fee market via amountOutMin
+		minOut,
+		deadline,
+		minOut,
+		deadline,
+	)
+	if err != nil {
+		return common.Address{}, nil, nil, fmt.Errorf("pack swapAndSend call: %w", err)
+	}
+
+	return h.contractAddr, data, big.NewInt(0), nil
+}
+
+// acrossSpokePoolABI is the minimal ABI fragment for Across Protocol's
+// SpokePool deposit entry point.
+const acrossSpokePoolABI = `[{
+	"name": "deposit",
+	"type": "function",
+	"inputs": [
+		{"name": "recipient", "type": "address"},
+		{"name": "originToken", "type": "address"},
+		{"name": "amount", "type": "uint256"},
+		{"name": "destinationChainId", "type": "uint256"},
+		{"name": "relayerFeePct", "type": "int64"},
+		{"name": "quoteTimestamp", "type": "uint32"}
+	]
+}]`
+
+// AcrossSpokePool implements BridgeProvider on top of Across Protocol's
+// SpokePool contract.
+type AcrossSpokePool struct {
+	contractAddr common.Address
+	originToken  common.Address
+	abi          abi.ABI
+}
+
+// NewAcrossSpokePool creates an AcrossSpokePool for the SpokePool deployed
+// at contractAddr on Arbitrum, bridging originToken (Arbitrum USDC).
+func NewAcrossSpokePool(contractAddr, originToken common.Address) (*AcrossSpokePool, error) {
+	parsed, err := abi.JSON(strings.NewReader(acrossSpokePoolABI))
+	if err != nil {
+		return nil, fmt.Errorf("parse across SpokePool ABI: %w", err)
+	}
+	return &AcrossSpokePool{contractAddr: contractAddr, originToken: originToken, abi: parsed}, nil
+}
+
+func (a *AcrossSpokePool) Kind() BridgeProviderKind {
+	return AcrossBridge
+}
+
+func (a *AcrossSpokePool) BuildCall(
+	_ context.Context,
+	req BridgeRequest,
+) (common.Address, []byte, *big.Int, error) {
+	// relayerFeePct is a signed fixed-point fraction (1e18 = 100%); derive
+	// it directly from the caller's slippage budget.
+	relayerFeePct := int64(req.SlippageBps) * 1e14
+
+	data, err := a.abi.Pack(
+		"deposit",
+		req.DestAddress,
+		a.originToken,
+		req.AmountWei,
+		new(big.Int).SetUint64(req.DestChainID),
+		relayerFeePct,
+		uint32(time.Now().Unix()),
+	)
+	if err != nil {
+		return common.Address{}, nil, nil, fmt.Errorf("pack deposit call: %w", err)
+	}
+
+	return a.contractAddr, data, big.NewInt(0), nil
+}
+
+// applySlippage returns amount reduced by slippageBps basis points, the
+// minimum-out bound passed to bridges that support one.
+func applySlippage(amount *big.Int, slippageBps int) *big.Int {
+	if slippageBps <= 0 {
+		return new(big.Int).Set(amount)
+	}
+	num := new(big.Int).Mul(amount, big.NewInt(10_000-int64(slippageBps)))
+	return num.Div(num, big.NewInt(10_000))
+}
@@ -0,0 +1,302 @@
+package hyperliquid
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// builderDexEventBuffer bounds BuilderDexRegistry.Watch's channel, the
+// same drop-oldest sizing eventEmitter uses for MarketData's views.
+const builderDexEventBuffer = 16
+
+// BuilderDexRegistryConfig configures NewBuilderDexRegistry's caching and
+// polling behavior.
+type BuilderDexRegistryConfig struct {
+	// ListTTL bounds how long the dex list PerpDexs reports is reused
+	// before List refetches it. Zero means every List call refetches.
+	ListTTL time.Duration
+	// LimitsTTL and StatusTTL bound how long Snapshot reuses a dex's
+	// PerpDexLimits/PerpDexStatus before refetching. Limits change far
+	// less often than status, hence the separate TTLs rather than one
+	// shared with Snapshot as a whole.
+	LimitsTTL time.Duration
+	StatusTTL time.Duration
+	// AuctionTTL bounds how long Snapshot reuses PerpDeployAuctionStatus,
+	// which is venue-wide rather than per-dex.
+	AuctionTTL time.Duration
+	// WatchInterval is how often Watch polls every dex List reports for
+	// changes. Zero disables Watch: the channel it returns is closed
+	// immediately.
+	WatchInterval time.Duration
+}
+
+// PerpDexInfo bundles one builder-deployed dex's limits, status, and the
+// venue-wide deploy-auction state, the three calls Snapshot fans out to
+// so callers stop threading them through by hand.
+type PerpDexInfo struct {
+	Dex     PerpDex
+	Limits  *PerpDexLimits
+	Status  *PerpDexStatus
+	Auction *PerpDeployAuctionStatus
+}
+
+// BuilderDexEventKind identifies which field of a PerpDexInfo changed
+// between two of Watch's polls.
+type BuilderDexEventKind int
+
+const (
+	// BuilderDexTotalNetDepositChanged means PerpDexStatus.TotalNetDeposit
+	// differs from the previous poll.
+	BuilderDexTotalNetDepositChanged BuilderDexEventKind = iota
+	// BuilderDexOiCapChanged means PerpDexLimits.TotalOiCap or
+	// OiSzCapPerPerp differs from the previous poll.
+	BuilderDexOiCapChanged
+	// BuilderDexAuctionGasChanged means
+	// PerpDeployAuctionStatus.CurrentGas differs from the previous poll.
+	BuilderDexAuctionGasChanged
+)
+
+// BuilderDexEvent is delivered on Watch's channel whenever a polled dex's
+// TotalNetDeposit, OI caps, or auction gas changes between polls.
+type BuilderDexEvent struct {
+	Kind BuilderDexEventKind
+	Dex  string
+	Prev string
+	Next string
+}
+
+// builderDexEntry is one memoized BuilderDexRegistry result, keyed by
+// cache key; mirrors metaCacheEntry.
+type builderDexEntry struct {
+	value     any
+	err       error
+	expiresAt time.Time
+}
+
+// builderDexCall is an in-flight fetch other callers for the same key
+// wait on instead of issuing their own; mirrors metaCacheCall.
+type builderDexCall struct {
+	done  chan struct{}
+	value any
+	err   error
+}
+
+// BuilderDexRegistry is a caching, deduplicating façade over Info's
+// builder-deployed-perp-dex endpoints (PerpDexs, PerpDexLimits,
+// PerpDexStatus, PerpDeployAuctionStatus). List and Snapshot skip the
+// default ("") dex automatically, and Watch polls for diffs instead of
+// requiring a caller-managed loop. Build one with
+// NewBuilderDexRegistry; the zero value is not usable.
+type BuilderDexRegistry struct {
+	info *Info
+	cfg  BuilderDexRegistryConfig
+
+	mu      sync.Mutex
+	entries map[string]*builderDexEntry
+	calls   map[string]*builderDexCall
+}
+
+// NewBuilderDexRegistry builds a BuilderDexRegistry that serves info's
+// builder-dex endpoints through cfg's per-field TTLs.
+func NewBuilderDexRegistry(info *Info, cfg BuilderDexRegistryConfig) *BuilderDexRegistry {
+	return &BuilderDexRegistry{
+		info:    info,
+		cfg:     cfg,
+		entries: make(map[string]*builderDexEntry),
+		calls:   make(map[string]*builderDexCall),
+	}
+}
+
+const builderDexCacheKeyList = "list"
+const builderDexCacheKeyAuction = "auction"
+
+func builderDexCacheKeyLimits(dex string) string { return "limits:" + dex }
+func builderDexCacheKeyStatus(dex string) string { return "status:" + dex }
+
+// List returns every builder-deployed dex PerpDexs reports, excluding the
+// default ("") entry PerpDexs itself still lists, so callers never
+// re-implement that filter.
+func (r *BuilderDexRegistry) List(ctx context.Context) ([]PerpDex, error) {
+	v, err := r.get(ctx, builderDexCacheKeyList, r.cfg.ListTTL, func() (any, error) {
+		names, err := r.info.PerpDexsWithContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		dexes := make([]PerpDex, 0, len(names))
+		for _, name := range names {
+			if name == "" {
+				continue
+			}
+			dexes = append(dexes, PerpDex{Name: name})
+		}
+		return dexes, nil
+	})
+	if v == nil {
+		return nil, err
+	}
+	return v.([]PerpDex), err
+}
+
+// Snapshot fetches name's PerpDexLimits and PerpDexStatus plus the
+// venue-wide PerpDeployAuctionStatus, each served from its own TTL-bound
+// cache entry and deduplicated across concurrent callers. name must be
+// non-empty, the same requirement PerpDexLimits/PerpDexStatus have.
+func (r *BuilderDexRegistry) Snapshot(ctx context.Context, name string) (PerpDexInfo, error) {
+	if name == "" {
+		return PerpDexInfo{}, ValidationError("dex", "dex parameter is required")
+	}
+
+	limitsV, limitsErr := r.get(ctx, builderDexCacheKeyLimits(name), r.cfg.LimitsTTL, func() (any, error) {
+		return r.info.PerpDexLimits(ctx, name)
+	})
+	if limitsErr != nil {
+		return PerpDexInfo{}, fmt.Errorf("snapshot %s: fetch limits: %w", name, limitsErr)
+	}
+
+	statusV, statusErr := r.get(ctx, builderDexCacheKeyStatus(name), r.cfg.StatusTTL, func() (any, error) {
+		return r.info.PerpDexStatus(ctx, name)
+	})
+	if statusErr != nil {
+		return PerpDexInfo{}, fmt.Errorf("snapshot %s: fetch status: %w", name, statusErr)
+	}
+
+	auctionV, auctionErr := r.get(ctx, builderDexCacheKeyAuction, r.cfg.AuctionTTL, func() (any, error) {
+		return r.info.PerpDeployAuctionStatus(ctx)
+	})
+	if auctionErr != nil {
+		return PerpDexInfo{}, fmt.Errorf("snapshot %s: fetch auction status: %w", name, auctionErr)
+	}
+
+	return PerpDexInfo{
+		Dex:     PerpDex{Name: name},
+		Limits:  limitsV.(*PerpDexLimits),
+		Status:  statusV.(*PerpDexStatus),
+		Auction: auctionV.(*PerpDeployAuctionStatus),
+	}, nil
+}
+
+// Watch polls List and Snapshot every cfg.WatchInterval and delivers a
+// BuilderDexEvent on the returned channel whenever a dex's
+// TotalNetDeposit, OI caps, or auction gas differs from the previous poll.
+// A slow consumer drops the oldest pending event rather than blocking the
+// poll loop, the same eventEmitter policy MarketData's views use. Watch
+// stops and closes the channel when ctx is done; cfg.WatchInterval <= 0
+// disables polling and returns an already-closed channel.
+func (r *BuilderDexRegistry) Watch(ctx context.Context) <-chan BuilderDexEvent {
+	out := make(chan BuilderDexEvent, builderDexEventBuffer)
+	if r.cfg.WatchInterval <= 0 {
+		close(out)
+		return out
+	}
+
+	go r.watchLoop(ctx, out)
+	return out
+}
+
+func (r *BuilderDexRegistry) watchLoop(ctx context.Context, out chan BuilderDexEvent) {
+	defer close(out)
+
+	ticker := time.NewTicker(r.cfg.WatchInterval)
+	defer ticker.Stop()
+
+	prev := make(map[string]PerpDexInfo)
+	poll := func() {
+		dexes, err := r.List(ctx)
+		if err != nil {
+			return
+		}
+		for _, dex := range dexes {
+			snap, err := r.Snapshot(ctx, dex.Name)
+			if err != nil {
+				continue
+			}
+			if last, ok := prev[dex.Name]; ok {
+				emitBuilderDexDiff(out, dex.Name, last, snap)
+			}
+			prev[dex.Name] = snap
+		}
+	}
+
+	poll()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			poll()
+		}
+	}
+}
+
+// emitBuilderDexDiff sends a BuilderDexEvent for each field of next that
+// differs from prev, dropping the oldest pending event on a full channel
+// per Watch's documented backpressure policy.
+func emitBuilderDexDiff(out chan BuilderDexEvent, dex string, prev, next PerpDexInfo) {
+	send := func(kind BuilderDexEventKind, prevVal, nextVal string) {
+		ev := BuilderDexEvent{Kind: kind, Dex: dex, Prev: prevVal, Next: nextVal}
+		select {
+		case out <- ev:
+			return
+		default:
+		}
+		select {
+		case <-out:
+		default:
+		}
+		select {
+		case out <- ev:
+		default:
+		}
+	}
+
+	if prev.Status != nil && next.Status != nil && prev.Status.TotalNetDeposit != next.Status.TotalNetDeposit {
+		send(BuilderDexTotalNetDepositChanged, prev.Status.TotalNetDeposit, next.Status.TotalNetDeposit)
+	}
+	if prev.Limits != nil && next.Limits != nil {
+		if prev.Limits.TotalOiCap != next.Limits.TotalOiCap {
+			send(BuilderDexOiCapChanged, prev.Limits.TotalOiCap, next.Limits.TotalOiCap)
+		}
+		if prev.Limits.OiSzCapPerPerp != next.Limits.OiSzCapPerPerp {
+			send(BuilderDexOiCapChanged, prev.Limits.OiSzCapPerPerp, next.Limits.OiSzCapPerPerp)
+		}
+	}
+	if prev.Auction != nil && next.Auction != nil && prev.Auction.CurrentGas != next.Auction.CurrentGas {
+		send(BuilderDexAuctionGasChanged, prev.Auction.CurrentGas, next.Auction.CurrentGas)
+	}
+}
+
+// get returns key's memoized value, fetching it through fetch on a miss or
+// TTL expiry. Concurrent callers racing the same miss share the one
+// in-flight fetch rather than each issuing their own; mirrors
+// MetaCache.get, parametrized on ttl since BuilderDexRegistry's fields
+// each have their own TTL rather than one shared across the cache.
+func (r *BuilderDexRegistry) get(ctx context.Context, key string, ttl time.Duration, fetch func() (any, error)) (any, error) {
+	r.mu.Lock()
+	if entry, ok := r.entries[key]; ok && time.Now().Before(entry.expiresAt) {
+		r.mu.Unlock()
+		return entry.value, entry.err
+	}
+	if call, ok := r.calls[key]; ok {
+		r.mu.Unlock()
+		<-call.done
+		return call.value, call.err
+	}
+
+	call := &builderDexCall{done: make(chan struct{})}
+	r.calls[key] = call
+	r.mu.Unlock()
+
+	value, err := fetch()
+
+	r.mu.Lock()
+	delete(r.calls, key)
+	r.entries[key] = &builderDexEntry{value: value, err: err, expiresAt: time.Now().Add(ttl)}
+	r.mu.Unlock()
+
+	call.value, call.err = value, err
+	close(call.done)
+
+	return value, err
+}
@@ -0,0 +1,107 @@
+package hyperliquid
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testBuilderDexRegistry(cfg BuilderDexRegistryConfig) *BuilderDexRegistry {
+	info := &Info{}
+	return NewBuilderDexRegistry(info, cfg)
+}
+
+func seedBuilderDexEntry(r *BuilderDexRegistry, key string, value any) {
+	r.entries[key] = &builderDexEntry{value: value, expiresAt: time.Now().Add(time.Hour)}
+}
+
+func TestBuilderDexRegistryListExcludesDefaultDex(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`[null,"test","builder2"]`))
+	}))
+	defer srv.Close()
+	r := NewBuilderDexRegistry(&Info{client: newClient(srv.URL)}, BuilderDexRegistryConfig{ListTTL: time.Hour})
+
+	dexes, err := r.List(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []PerpDex{{Name: "test"}, {Name: "builder2"}}, dexes)
+}
+
+func TestBuilderDexRegistrySnapshotBundlesLimitsStatusAndAuction(t *testing.T) {
+	r := testBuilderDexRegistry(BuilderDexRegistryConfig{LimitsTTL: time.Hour, StatusTTL: time.Hour, AuctionTTL: time.Hour})
+	seedBuilderDexEntry(r, builderDexCacheKeyLimits("test"), &PerpDexLimits{TotalOiCap: "1000000"})
+	seedBuilderDexEntry(r, builderDexCacheKeyStatus("test"), &PerpDexStatus{TotalNetDeposit: "500"})
+	seedBuilderDexEntry(r, builderDexCacheKeyAuction, &PerpDeployAuctionStatus{CurrentGas: "100"})
+
+	snap, err := r.Snapshot(context.Background(), "test")
+	require.NoError(t, err)
+	assert.Equal(t, PerpDex{Name: "test"}, snap.Dex)
+	assert.Equal(t, "1000000", snap.Limits.TotalOiCap)
+	assert.Equal(t, "500", snap.Status.TotalNetDeposit)
+	assert.Equal(t, "100", snap.Auction.CurrentGas)
+}
+
+func TestBuilderDexRegistrySnapshotRequiresNonEmptyDex(t *testing.T) {
+	r := testBuilderDexRegistry(BuilderDexRegistryConfig{})
+
+	_, err := r.Snapshot(context.Background(), "")
+	assert.ErrorIs(t, err, ErrInvalidParameter)
+}
+
+func TestEmitBuilderDexDiffReportsChangedFields(t *testing.T) {
+	out := make(chan BuilderDexEvent, builderDexEventBuffer)
+
+	prev := PerpDexInfo{
+		Limits:  &PerpDexLimits{TotalOiCap: "1000000", OiSzCapPerPerp: "100"},
+		Status:  &PerpDexStatus{TotalNetDeposit: "500"},
+		Auction: &PerpDeployAuctionStatus{CurrentGas: "100"},
+	}
+	next := PerpDexInfo{
+		Limits:  &PerpDexLimits{TotalOiCap: "2000000", OiSzCapPerPerp: "100"},
+		Status:  &PerpDexStatus{TotalNetDeposit: "700"},
+		Auction: &PerpDeployAuctionStatus{CurrentGas: "120"},
+	}
+
+	emitBuilderDexDiff(out, "test", prev, next)
+	close(out)
+
+	var kinds []BuilderDexEventKind
+	for ev := range out {
+		assert.Equal(t, "test", ev.Dex)
+		kinds = append(kinds, ev.Kind)
+	}
+	assert.ElementsMatch(t, []BuilderDexEventKind{
+		BuilderDexTotalNetDepositChanged,
+		BuilderDexOiCapChanged,
+		BuilderDexAuctionGasChanged,
+	}, kinds)
+}
+
+func TestEmitBuilderDexDiffReportsNothingWhenUnchanged(t *testing.T) {
+	out := make(chan BuilderDexEvent, builderDexEventBuffer)
+
+	snap := PerpDexInfo{
+		Limits:  &PerpDexLimits{TotalOiCap: "1000000"},
+		Status:  &PerpDexStatus{TotalNetDeposit: "500"},
+		Auction: &PerpDeployAuctionStatus{CurrentGas: "100"},
+	}
+
+	emitBuilderDexDiff(out, "test", snap, snap)
+	close(out)
+
+	_, ok := <-out
+	assert.False(t, ok)
+}
+
+func TestBuilderDexRegistryWatchDisabledWithoutInterval(t *testing.T) {
+	r := testBuilderDexRegistry(BuilderDexRegistryConfig{})
+
+	ch := r.Watch(context.Background())
+	_, ok := <-ch
+	assert.False(t, ok)
+}
@@ -0,0 +1,228 @@
+// Package cienv detects which CI provider the current process is running
+// under and, like the cucumber ci-environment library, extracts a
+// normalized BuildInfo from that provider's own environment variables
+// instead of leaving callers to special-case each provider themselves.
+package cienv
+
+import "os"
+
+// Provider identifies a CI platform Detect recognizes. The empty
+// Provider ("") means no known CI provider was detected.
+type Provider string
+
+const (
+	ProviderGitHubActions      Provider = "github-actions"
+	ProviderGitLabCI           Provider = "gitlab-ci"
+	ProviderCircleCI           Provider = "circleci"
+	ProviderTravisCI           Provider = "travis-ci"
+	ProviderJenkins            Provider = "jenkins"
+	ProviderBuildkite          Provider = "buildkite"
+	ProviderDrone              Provider = "drone"
+	ProviderTeamCity           Provider = "teamcity"
+	ProviderBitbucketPipelines Provider = "bitbucket-pipelines"
+	ProviderAzureDevOps        Provider = "azure-devops"
+)
+
+// BuildInfo is the normalized CI metadata Detect extracts from the
+// current provider's environment variables. The zero value (Provider =="")
+// means Detect found no recognized CI provider.
+type BuildInfo struct {
+	Provider    Provider
+	BuildURL    string
+	CommitSHA   string
+	Branch      string
+	Tag         string
+	BuildNumber string
+}
+
+// IsCI reports whether the current process appears to be running under
+// any CI provider Detect recognizes.
+func IsCI() bool {
+	return Detect().Provider != ""
+}
+
+// detectors is ordered so the common hosted providers (GitHub Actions,
+// GitLab CI, CircleCI) are checked first, since they're the likeliest
+// match for this module's own CI-run integration tests.
+var detectors = []func() (BuildInfo, bool){
+	detectGitHubActions,
+	detectGitLabCI,
+	detectCircleCI,
+	detectTravisCI,
+	detectJenkins,
+	detectBuildkite,
+	detectDrone,
+	detectTeamCity,
+	detectBitbucketPipelines,
+	detectAzureDevOps,
+}
+
+// Detect inspects the process environment and returns the BuildInfo for
+// the first CI provider it recognizes, or a zero BuildInfo outside CI.
+func Detect() BuildInfo {
+	for _, detect := range detectors {
+		if info, ok := detect(); ok {
+			return info
+		}
+	}
+	return BuildInfo{}
+}
+
+func detectGitHubActions() (BuildInfo, bool) {
+	if os.Getenv("GITHUB_ACTIONS") != "true" {
+		return BuildInfo{}, false
+	}
+
+	var buildURL string
+	if serverURL, repo, runID := os.Getenv("GITHUB_SERVER_URL"), os.Getenv("GITHUB_REPOSITORY"), os.Getenv("GITHUB_RUN_ID"); serverURL != "" && repo != "" && runID != "" {
+		buildURL = serverURL + "/" + repo + "/actions/runs/" + runID
+	}
+
+	var tag string
+	if os.Getenv("GITHUB_REF_TYPE") == "tag" {
+		tag = os.Getenv("GITHUB_REF_NAME")
+	}
+
+	return BuildInfo{
+		Provider:    ProviderGitHubActions,
+		BuildURL:    buildURL,
+		CommitSHA:   os.Getenv("GITHUB_SHA"),
+		Branch:      os.Getenv("GITHUB_REF_NAME"),
+		Tag:         tag,
+		BuildNumber: os.Getenv("GITHUB_RUN_NUMBER"),
+	}, true
+}
+
+func detectGitLabCI() (BuildInfo, bool) {
+	if os.Getenv("GITLAB_CI") != "true" {
+		return BuildInfo{}, false
+	}
+	return BuildInfo{
+		Provider:    ProviderGitLabCI,
+		BuildURL:    os.Getenv("CI_JOB_URL"),
+		CommitSHA:   os.Getenv("CI_COMMIT_SHA"),
+		Branch:      os.Getenv("CI_COMMIT_BRANCH"),
+		Tag:         os.Getenv("CI_COMMIT_TAG"),
+		BuildNumber: os.Getenv("CI_JOB_ID"),
+	}, true
+}
+
+func detectCircleCI() (BuildInfo, bool) {
+	if os.Getenv("CIRCLECI") != "true" {
+		return BuildInfo{}, false
+	}
+	return BuildInfo{
+		Provider:    ProviderCircleCI,
+		BuildURL:    os.Getenv("CIRCLE_BUILD_URL"),
+		CommitSHA:   os.Getenv("CIRCLE_SHA1"),
+		Branch:      os.Getenv("CIRCLE_BRANCH"),
+		Tag:         os.Getenv("CIRCLE_TAG"),
+		BuildNumber: os.Getenv("CIRCLE_BUILD_NUM"),
+	}, true
+}
+
+func detectTravisCI() (BuildInfo, bool) {
+	if os.Getenv("TRAVIS") != "true" {
+		return BuildInfo{}, false
+	}
+	return BuildInfo{
+		Provider:    ProviderTravisCI,
+		BuildURL:    os.Getenv("TRAVIS_BUILD_WEB_URL"),
+		CommitSHA:   os.Getenv("TRAVIS_COMMIT"),
+		Branch:      os.Getenv("TRAVIS_BRANCH"),
+		Tag:         os.Getenv("TRAVIS_TAG"),
+		BuildNumber: os.Getenv("TRAVIS_BUILD_NUMBER"),
+	}, true
+}
+
+func detectJenkins() (BuildInfo, bool) {
+	if os.Getenv("JENKINS_URL") == "" {
+		return BuildInfo{}, false
+	}
+	return BuildInfo{
+		Provider:    ProviderJenkins,
+		BuildURL:    os.Getenv("BUILD_URL"),
+		CommitSHA:   os.Getenv("GIT_COMMIT"),
+		Branch:      os.Getenv("GIT_BRANCH"),
+		BuildNumber: os.Getenv("BUILD_NUMBER"),
+	}, true
+}
+
+func detectBuildkite() (BuildInfo, bool) {
+	if os.Getenv("BUILDKITE") != "true" {
+		return BuildInfo{}, false
+	}
+	return BuildInfo{
+		Provider:    ProviderBuildkite,
+		BuildURL:    os.Getenv("BUILDKITE_BUILD_URL"),
+		CommitSHA:   os.Getenv("BUILDKITE_COMMIT"),
+		Branch:      os.Getenv("BUILDKITE_BRANCH"),
+		Tag:         os.Getenv("BUILDKITE_TAG"),
+		BuildNumber: os.Getenv("BUILDKITE_BUILD_NUMBER"),
+	}, true
+}
+
+func detectDrone() (BuildInfo, bool) {
+	if os.Getenv("DRONE") != "true" {
+		return BuildInfo{}, false
+	}
+	return BuildInfo{
+		Provider:    ProviderDrone,
+		BuildURL:    os.Getenv("DRONE_BUILD_LINK"),
+		CommitSHA:   os.Getenv("DRONE_COMMIT_SHA"),
+		Branch:      os.Getenv("DRONE_BRANCH"),
+		Tag:         os.Getenv("DRONE_TAG"),
+		BuildNumber: os.Getenv("DRONE_BUILD_NUMBER"),
+	}, true
+}
+
+func detectTeamCity() (BuildInfo, bool) {
+	if os.Getenv("TEAMCITY_VERSION") == "" {
+		return BuildInfo{}, false
+	}
+	return BuildInfo{
+		Provider:    ProviderTeamCity,
+		CommitSHA:   os.Getenv("BUILD_VCS_NUMBER"),
+		Branch:      os.Getenv("TEAMCITY_BUILD_BRANCH"),
+		BuildNumber: os.Getenv("BUILD_NUMBER"),
+	}, true
+}
+
+func detectBitbucketPipelines() (BuildInfo, bool) {
+	if os.Getenv("BITBUCKET_BUILD_NUMBER") == "" {
+		return BuildInfo{}, false
+	}
+
+	var buildURL string
+	if repo := os.Getenv("BITBUCKET_GIT_HTTP_ORIGIN"); repo != "" {
+		buildURL = repo + "/addon/pipelines/home#!/results/" + os.Getenv("BITBUCKET_BUILD_NUMBER")
+	}
+
+	return BuildInfo{
+		Provider:    ProviderBitbucketPipelines,
+		BuildURL:    buildURL,
+		CommitSHA:   os.Getenv("BITBUCKET_COMMIT"),
+		Branch:      os.Getenv("BITBUCKET_BRANCH"),
+		Tag:         os.Getenv("BITBUCKET_TAG"),
+		BuildNumber: os.Getenv("BITBUCKET_BUILD_NUMBER"),
+	}, true
+}
+
+func detectAzureDevOps() (BuildInfo, bool) {
+	if tfBuild := os.Getenv("TF_BUILD"); tfBuild != "True" && tfBuild != "true" {
+		return BuildInfo{}, false
+	}
+
+	var buildURL string
+	if uri, proj := os.Getenv("SYSTEM_TEAMFOUNDATIONSERVERURI"), os.Getenv("SYSTEM_TEAMPROJECT"); uri != "" && proj != "" {
+		buildURL = uri + proj + "/_build/results?buildId=" + os.Getenv("BUILD_BUILDID")
+	}
+
+	return BuildInfo{
+		Provider:    ProviderAzureDevOps,
+		BuildURL:    buildURL,
+		CommitSHA:   os.Getenv("BUILD_SOURCEVERSION"),
+		Branch:      os.Getenv("BUILD_SOURCEBRANCHNAME"),
+		BuildNumber: os.Getenv("BUILD_BUILDID"),
+	}, true
+}
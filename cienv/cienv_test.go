@@ -0,0 +1,66 @@
+package cienv
+
+import "testing"
+
+func TestDetectGitHubActions(t *testing.T) {
+	t.Setenv("GITHUB_ACTIONS", "true")
+	t.Setenv("GITHUB_SHA", "abc123")
+	t.Setenv("GITHUB_REF_NAME", "main")
+	t.Setenv("GITHUB_REF_TYPE", "branch")
+	t.Setenv("GITHUB_RUN_NUMBER", "42")
+	t.Setenv("GITHUB_SERVER_URL", "https://github.com")
+	t.Setenv("GITHUB_REPOSITORY", "sonirico/go-hyperliquid")
+	t.Setenv("GITHUB_RUN_ID", "99")
+
+	info := Detect()
+	if info.Provider != ProviderGitHubActions {
+		t.Fatalf("expected provider %q, got %q", ProviderGitHubActions, info.Provider)
+	}
+	if info.CommitSHA != "abc123" {
+		t.Fatalf("expected commit sha %q, got %q", "abc123", info.CommitSHA)
+	}
+	if info.Branch != "main" {
+		t.Fatalf("expected branch %q, got %q", "main", info.Branch)
+	}
+	if info.BuildURL != "https://github.com/sonirico/go-hyperliquid/actions/runs/99" {
+		t.Fatalf("unexpected build url %q", info.BuildURL)
+	}
+	if !IsCI() {
+		t.Fatal("expected IsCI to report true")
+	}
+}
+
+func TestDetectGitLabCI(t *testing.T) {
+	t.Setenv("GITLAB_CI", "true")
+	t.Setenv("CI_COMMIT_SHA", "def456")
+	t.Setenv("CI_COMMIT_BRANCH", "develop")
+	t.Setenv("CI_JOB_ID", "7")
+
+	info := Detect()
+	if info.Provider != ProviderGitLabCI {
+		t.Fatalf("expected provider %q, got %q", ProviderGitLabCI, info.Provider)
+	}
+	if info.CommitSHA != "def456" {
+		t.Fatalf("expected commit sha %q, got %q", "def456", info.CommitSHA)
+	}
+	if info.BuildNumber != "7" {
+		t.Fatalf("expected build number %q, got %q", "7", info.BuildNumber)
+	}
+}
+
+func TestDetectNoProvider(t *testing.T) {
+	for _, key := range []string{
+		"GITHUB_ACTIONS", "GITLAB_CI", "CIRCLECI", "TRAVIS", "JENKINS_URL",
+		"BUILDKITE", "DRONE", "TEAMCITY_VERSION", "BITBUCKET_BUILD_NUMBER", "TF_BUILD",
+	} {
+		t.Setenv(key, "")
+	}
+
+	info := Detect()
+	if info.Provider != "" {
+		t.Fatalf("expected no provider, got %q", info.Provider)
+	}
+	if IsCI() {
+		t.Fatal("expected IsCI to report false")
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/sonirico/vago/lol"
 )
@@ -23,11 +24,45 @@ const (
 	httpErrorStatusCode = 400
 )
 
+// RequestMethod selects how client issues a request to an Info endpoint.
+type RequestMethod int
+
+const (
+	// MethodPOST always issues a POST, the default and the only method
+	// Hyperliquid's REST API documents.
+	MethodPOST RequestMethod = iota
+	// MethodPOSTWithFallback issues a POST first and, on a response
+	// status in the client's fallback set (405/404 by default), retries
+	// once as a GET with the payload encoded as a query parameter. This
+	// mirrors the fallback the Prometheus Go client's Query/QueryRange
+	// use, for deployments where a reverse proxy or WAF rejects large
+	// POST bodies but still forwards GETs.
+	MethodPOSTWithFallback
+)
+
+// requestMethodFallbackQueryParam is the query parameter the GET fallback
+// encodes the POST payload's JSON into, decoded server-side the same way
+// a POST body would be.
+const requestMethodFallbackQueryParam = "body"
+
+// defaultFallbackStatuses is the status set MethodPOSTWithFallback retries
+// on when ClientOptRequestMethod is not given an explicit set.
+func defaultFallbackStatuses() map[int]bool {
+	return map[int]bool{http.StatusMethodNotAllowed: true, http.StatusNotFound: true}
+}
+
 type client struct {
 	logger     lol.Logger
 	debug      bool
 	baseURL    string
 	httpClient *http.Client
+
+	requestMethod    RequestMethod
+	fallbackStatuses map[int]bool
+
+	// metrics, set via ClientOptMetrics, receives RPC instrumentation; see
+	// metrics.go. Defaults to a no-op sink.
+	metrics MetricsSink
 }
 
 func newClient(baseURL string, opts ...ClientOpt) *client {
@@ -36,8 +71,10 @@ func newClient(baseURL string, opts ...ClientOpt) *client {
 	}
 
 	cli := &client{
-		baseURL:    baseURL,
-		httpClient: new(http.Client),
+		baseURL:          baseURL,
+		httpClient:       new(http.Client),
+		fallbackStatuses: defaultFallbackStatuses(),
+		metrics:          noopMetricsSink{},
 	}
 
 	for _, opt := range opts {
@@ -47,36 +84,118 @@ func newClient(baseURL string, opts ...ClientOpt) *client {
 	return cli
 }
 
+// ClientOptRequestMethod configures a client's RequestMethod and,
+// optionally, which response statuses MethodPOSTWithFallback retries on
+// (405 and 404 when fallbackStatuses is empty).
+func ClientOptRequestMethod(method RequestMethod, fallbackStatuses ...int) ClientOpt {
+	return func(c *Client) {
+		c.requestMethod = method
+		if len(fallbackStatuses) > 0 {
+			set := make(map[int]bool, len(fallbackStatuses))
+			for _, status := range fallbackStatuses {
+				set[status] = true
+			}
+			c.fallbackStatuses = set
+		}
+	}
+}
+
+// ClientOptMetrics configures a client to record every RPC's request
+// count, latency, retries, and rate-limit hits against sink.
+func ClientOptMetrics(sink MetricsSink) ClientOpt {
+	return func(c *Client) {
+		if sink != nil {
+			c.metrics = sink
+		}
+	}
+}
+
 func (c *client) post(ctx context.Context, path string, payload any) ([]byte, error) {
+	start := time.Now()
+	endpoint := endpointNameFromPayload(payload, path)
+
 	jsonData, err := json.Marshal(payload)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal payload: %w", err)
+		return nil, &NetworkError{Err: fmt.Errorf("failed to marshal payload: %w", err)}
 	}
 
-	url := c.baseURL + path
-	req, err := http.NewRequestWithContext(
-		ctx,
-		http.MethodPost,
-		url,
-		bytes.NewBuffer(jsonData),
-	)
+	body, status, err := c.do(ctx, http.MethodPost, path, jsonData)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		c.metrics.ObserveLatency(endpoint, time.Since(start))
+		c.metrics.IncRequest(endpoint, "error")
+		return nil, &NetworkError{Err: err}
+	}
+
+	if c.requestMethod == MethodPOSTWithFallback && c.fallbackStatuses[status] {
+		c.metrics.IncRetry(endpoint)
+		body, status, err = c.do(ctx, http.MethodGet, path, jsonData)
+		if err != nil {
+			c.metrics.ObserveLatency(endpoint, time.Since(start))
+			c.metrics.IncRequest(endpoint, "error")
+			return nil, &NetworkError{Err: err}
+		}
+	}
+
+	c.metrics.ObserveLatency(endpoint, time.Since(start))
+	if status == http.StatusTooManyRequests {
+		c.metrics.IncRateLimitHit(endpoint)
 	}
 
-	req.Header.Set("Content-Type", "application/json")
+	if status >= httpErrorStatusCode {
+		c.metrics.IncRequest(endpoint, "error")
+		if !json.Valid(body) {
+			return nil, &InfoAPIError{Status: status, Message: string(body)}
+		}
+		var apiErr APIError
+		if err := json.Unmarshal(body, &apiErr); err != nil {
+			return nil, &InfoAPIError{Status: status, Message: string(body)}
+		}
+		return nil, &InfoAPIError{Status: status, Code: apiErr.Code, Message: apiErr.Message}
+	}
+
+	c.metrics.IncRequest(endpoint, "ok")
+	return body, nil
+}
+
+// do issues one HTTP round trip: method POST sends jsonData as the request
+// body, method GET encodes it into the requestMethodFallbackQueryParam
+// query parameter instead, since GET requests carry no body. The response
+// body is always fully read before the connection is released (deferred
+// Close runs after io.ReadAll), so a retried request reuses the
+// connection instead of forcing a new dial.
+func (c *client) do(ctx context.Context, method, path string, jsonData []byte) ([]byte, int, error) {
+	url := c.baseURL + path
+
+	var req *http.Request
+	var err error
+	if method == http.MethodGet {
+		req, err = http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err == nil {
+			q := req.URL.Query()
+			q.Set(requestMethodFallbackQueryParam, string(jsonData))
+			req.URL.RawQuery = q.Encode()
+		}
+	} else {
+		req, err = http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(jsonData))
+		if err == nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+	}
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to create request: %w", err)
+	}
 
 	if c.debug {
 		c.logger.WithFields(lol.Fields{
-			"method": "POST",
-			"url":    url,
+			"method": method,
+			"url":    req.URL.String(),
 			"body":   string(jsonData),
 		}).Debug("HTTP request")
 	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		return nil, 0, fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
@@ -84,7 +203,7 @@ func (c *client) post(ctx context.Context, path string, payload any) ([]byte, er
 	if resp.Body != nil {
 		body, err = io.ReadAll(resp.Body)
 		if err != nil {
-			return nil, fmt.Errorf("failed to read response body: %w", err)
+			return nil, resp.StatusCode, fmt.Errorf("failed to read response body: %w", err)
 		}
 	}
 
@@ -95,16 +214,5 @@ func (c *client) post(ctx context.Context, path string, payload any) ([]byte, er
 		}).Debug("HTTP response")
 	}
 
-	if resp.StatusCode >= httpErrorStatusCode {
-		if !json.Valid(body) {
-			return nil, fmt.Errorf("status %d: %s", resp.StatusCode, string(body))
-		}
-		var apiErr APIError
-		if err := json.Unmarshal(body, &apiErr); err != nil {
-			return nil, fmt.Errorf("status %d: %s", resp.StatusCode, string(body))
-		}
-		return nil, apiErr
-	}
-
-	return body, nil
+	return body, resp.StatusCode, nil
 }
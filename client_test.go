@@ -0,0 +1,104 @@
+package hyperliquid
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httptrace"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientPostFallsBackToGetOn405(t *testing.T) {
+	var postCount, getCount int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			atomic.AddInt32(&postCount, 1)
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			_, _ = w.Write([]byte(`{"error":"use GET"}`))
+		case http.MethodGet:
+			atomic.AddInt32(&getCount, 1)
+			assert.Equal(t, `{"type":"meta"}`, r.URL.Query().Get(requestMethodFallbackQueryParam))
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"ok":true}`))
+		default:
+			t.Fatalf("unexpected method %s", r.Method)
+		}
+	}))
+	defer srv.Close()
+
+	c := newClient(srv.URL, ClientOptRequestMethod(MethodPOSTWithFallback))
+
+	body, err := c.post(context.Background(), "/info", map[string]any{"type": "meta"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"ok":true}`, string(body))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&postCount))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&getCount))
+}
+
+func TestClientPostWithoutFallbackReturnsErrorOn405(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		_, _ = w.Write([]byte(`{"error":"nope"}`))
+	}))
+	defer srv.Close()
+
+	c := newClient(srv.URL)
+
+	_, err := c.post(context.Background(), "/info", map[string]any{"type": "meta"})
+	require.Error(t, err)
+
+	var apiErr *InfoAPIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusMethodNotAllowed, apiErr.Status)
+	assert.ErrorIs(t, err, ErrAPIError)
+}
+
+func TestClientPostReturnsRateLimitedOn429(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = w.Write([]byte(`{"code":429,"msg":"rate limited"}`))
+	}))
+	defer srv.Close()
+
+	c := newClient(srv.URL)
+
+	_, err := c.post(context.Background(), "/info", map[string]any{"type": "meta"})
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrRateLimited)
+}
+
+// TestClientPostDrainsFallbackResponseBodyBeforeReusingConnection verifies
+// the POST attempt's response body is fully read before its connection is
+// released, so the GET retry reuses it instead of forcing a new dial.
+func TestClientPostDrainsFallbackResponseBodyBeforeReusingConnection(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			_, _ = w.Write([]byte(`{"error":"use GET"}`))
+		case http.MethodGet:
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"ok":true}`))
+		}
+	}))
+	defer srv.Close()
+
+	c := newClient(srv.URL, ClientOptRequestMethod(MethodPOSTWithFallback))
+
+	var reused bool
+	trace := &httptrace.ClientTrace{
+		GotConn: func(info httptrace.GotConnInfo) {
+			reused = info.Reused
+		},
+	}
+	ctx := httptrace.WithClientTrace(context.Background(), trace)
+
+	_, err := c.post(ctx, "/info", map[string]any{"type": "meta"})
+	require.NoError(t, err)
+	assert.True(t, reused, "expected the GET fallback to reuse the POST's connection")
+}
@@ -0,0 +1,62 @@
+// Command wsrecord connects to the Hyperliquid WebSocket API, subscribes to
+// a single channel, and writes the first frame it receives to a conformance
+// vector file under testdata/vectors/, replacing any existing file. Run it to refresh the corpus exercised by
+// TestConformanceVectors after the server starts sending a new field or
+// channel shape.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	hyperliquid "github.com/sonirico/go-hyperliquid"
+)
+
+func main() {
+	var (
+		channel = flag.String("channel", "trades", "channel to subscribe to")
+		coin    = flag.String("coin", "BTC", "coin for coin-scoped channels")
+		user    = flag.String("user", "", "user address for user-scoped channels")
+		out     = flag.String("out", "", "output file; defaults to testdata/vectors/<channel>.json")
+	)
+	flag.Parse()
+
+	if *out == "" {
+		*out = fmt.Sprintf("testdata/vectors/%s.json", *channel)
+	}
+
+	client := hyperliquid.NewWebsocketClient(hyperliquid.MainnetAPIURL)
+	if err := client.Connect(context.Background()); err != nil {
+		log.Fatalf("connect: %v", err)
+	}
+	defer client.Close()
+
+	sub := hyperliquid.Subscription{Type: *channel, Coin: *coin, User: *user}
+
+	captured := make(chan hyperliquid.WSMessage, 1)
+	id, err := client.Subscribe(sub, func(msg hyperliquid.WSMessage) {
+		select {
+		case captured <- msg:
+		default:
+		}
+	})
+	if err != nil {
+		log.Fatalf("subscribe: %v", err)
+	}
+	defer client.Unsubscribe(sub, id)
+
+	frame := <-captured
+
+	raw, err := json.MarshalIndent(frame, "", "  ")
+	if err != nil {
+		log.Fatalf("marshal: %v", err)
+	}
+
+	if err := os.WriteFile(*out, raw, 0o644); err != nil {
+		log.Fatalf("write %s: %v", *out, err)
+	}
+}
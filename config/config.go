@@ -0,0 +1,300 @@
+// Package config loads Hyperliquid client configuration through an
+// explicit precedence chain: programmatic overrides > process env >
+// per-profile ".env.<profile>" files > a base ".env" file > compiled-in
+// defaults, into a single typed Config returned by Load or MustLoad. The
+// examples package does not use it: its tests still load their environment
+// through examples.loadEnvClean (godotenv.Overload plus HL_* clearing).
+package config
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/joho/godotenv"
+
+	hyperliquid "github.com/sonirico/go-hyperliquid"
+	"github.com/sonirico/go-hyperliquid/cienv"
+)
+
+// Profile selects which deployment Load targets, chosen via HL_PROFILE.
+// Unset HL_PROFILE defaults to ProfileTestnet, the same default the
+// examples package's newTestInfo/newTestExchange helpers already assume.
+type Profile string
+
+const (
+	ProfileLocal   Profile = "local"
+	ProfileTestnet Profile = "testnet"
+	ProfileMainnet Profile = "mainnet"
+)
+
+const defaultProfile = ProfileTestnet
+
+// Timeouts groups the durations Config loads for the underlying HTTP/WS
+// clients. Zero means "use the library's own default" rather than zero
+// duration.
+type Timeouts struct {
+	Request time.Duration
+	Dial    time.Duration
+}
+
+// RateLimits groups the request pacing Config loads for order submission.
+// Zero means unlimited.
+type RateLimits struct {
+	RequestsPerSecond float64
+	Burst             int
+}
+
+// Config is the fully resolved configuration for a Hyperliquid client,
+// returned by Load/MustLoad. A zero-value field means no overlay set it and
+// the caller should fall back to the library's own default.
+type Config struct {
+	Profile        Profile
+	PrivateKey     string
+	AccountAddress string
+	Network        string
+	Dex            string
+	APIBaseURL     string
+	WSBaseURL      string
+	Timeouts       Timeouts
+	RateLimits     RateLimits
+
+	// MainnetAck must be true for Validate to accept a Config whose
+	// Profile is ProfileMainnet, so a mistakenly-selected mainnet profile
+	// doesn't silently start placing real-money orders.
+	MainnetAck bool
+}
+
+// Load resolves a Config for profile, layering (lowest to highest
+// priority) compiled-in defaults, ".env", ".env.<profile>", the process
+// environment, and finally overrides. In CI (cienv.IsCI()), the ".env"/
+// ".env.<profile>" overlays are skipped entirely so CI-injected credentials
+// in the process env are never shadowed by a file checked into the repo.
+// An empty profile is taken from HL_PROFILE, or defaultProfile when that is
+// unset too.
+func Load(profile Profile, overrides Config) (Config, error) {
+	if profile == "" {
+		profile = Profile(os.Getenv("HL_PROFILE"))
+	}
+	if profile == "" {
+		profile = defaultProfile
+	}
+
+	cfg := defaults(profile)
+
+	if !cienv.IsCI() {
+		cfg.applyEnv(readEnvFile(".env"))
+		cfg.applyEnv(readEnvFile(fmt.Sprintf(".env.%s", profile)))
+	}
+
+	cfg.applyEnv(processEnv())
+	cfg.applyOverrides(overrides)
+
+	return cfg, nil
+}
+
+// MustLoad is Load (an empty profile resolved the same way) with any error (including Validate's) turned into a panic, for examples and
+// tests that can't do anything useful except stop on a bad environment.
+func MustLoad(profile Profile, overrides Config) Config {
+	cfg, err := Load(profile, overrides)
+	if err != nil {
+		panic(fmt.Sprintf("config: failed to load: %v", err))
+	}
+	if err := cfg.Validate(); err != nil {
+		panic(fmt.Sprintf("config: invalid configuration: %v", err))
+	}
+	return cfg
+}
+
+// defaults returns the compiled-in base Config for profile, before any
+// overlay is applied.
+func defaults(profile Profile) Config {
+	cfg := Config{
+		Profile:    profile,
+		Network:    string(profile),
+		APIBaseURL: hyperliquid.TestnetAPIURL,
+		WSBaseURL:  hyperliquid.TestnetAPIURL,
+	}
+
+	switch profile {
+	case ProfileMainnet:
+		cfg.APIBaseURL = hyperliquid.MainnetAPIURL
+		cfg.WSBaseURL = hyperliquid.MainnetAPIURL
+	case ProfileLocal:
+		cfg.APIBaseURL = hyperliquid.LocalAPIURL
+		cfg.WSBaseURL = hyperliquid.LocalAPIURL
+	}
+
+	return cfg
+}
+
+// readEnvFile reads filename as a dotenv file without touching the process
+// environment, so layering it underneath process env never clobbers a
+// variable the process (or CI) already set. A missing file yields an empty
+// map rather than an error, since ".env"/".env.<profile>" are optional
+// overlays.
+func readEnvFile(filename string) map[string]string {
+	vars, err := godotenv.Read(filename)
+	if err != nil {
+		return nil
+	}
+	return vars
+}
+
+// processEnv snapshots the HL_* environment variables Load reads, in the
+// same shape readEnvFile returns, so applyEnv can treat both sources
+// identically.
+func processEnv() map[string]string {
+	vars := make(map[string]string)
+	for _, key := range []string{
+		"HL_PROFILE",
+		"HL_PRIVATE_KEY",
+		"HL_WALLET_ADDRESS",
+		"HL_NETWORK",
+		"HL_DEX",
+		"HL_API_URL",
+		"HL_WS_URL",
+		"HL_REQUEST_TIMEOUT",
+		"HL_DIAL_TIMEOUT",
+		"HL_RATE_LIMIT_RPS",
+		"HL_RATE_LIMIT_BURST",
+		"HL_MAINNET_ACK",
+	} {
+		if v, ok := os.LookupEnv(key); ok {
+			vars[key] = v
+		}
+	}
+	return vars
+}
+
+// applyEnv overlays vars onto c, skipping any key that's absent or empty so
+// an earlier, lower-priority layer's value survives.
+func (c *Config) applyEnv(vars map[string]string) {
+	if v := vars["HL_PRIVATE_KEY"]; v != "" {
+		c.PrivateKey = v
+	}
+	if v := vars["HL_WALLET_ADDRESS"]; v != "" {
+		c.AccountAddress = v
+	}
+	if v := vars["HL_NETWORK"]; v != "" {
+		c.Network = v
+	}
+	if v := vars["HL_DEX"]; v != "" {
+		c.Dex = v
+	}
+	if v := vars["HL_API_URL"]; v != "" {
+		c.APIBaseURL = v
+	}
+	if v := vars["HL_WS_URL"]; v != "" {
+		c.WSBaseURL = v
+	}
+	if v := vars["HL_REQUEST_TIMEOUT"]; v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			c.Timeouts.Request = d
+		}
+	}
+	if v := vars["HL_DIAL_TIMEOUT"]; v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			c.Timeouts.Dial = d
+		}
+	}
+	if v := vars["HL_RATE_LIMIT_RPS"]; v != "" {
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			c.RateLimits.RequestsPerSecond = f
+		}
+	}
+	if v := vars["HL_RATE_LIMIT_BURST"]; v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			c.RateLimits.Burst = n
+		}
+	}
+	if v := vars["HL_MAINNET_ACK"]; v != "" {
+		c.MainnetAck = v == "true"
+	}
+}
+
+// applyOverrides overlays the non-zero fields of overrides onto c, giving
+// programmatic callers the final say over every other layer.
+func (c *Config) applyOverrides(overrides Config) {
+	if overrides.Profile != "" {
+		c.Profile = overrides.Profile
+	}
+	if overrides.PrivateKey != "" {
+		c.PrivateKey = overrides.PrivateKey
+	}
+	if overrides.AccountAddress != "" {
+		c.AccountAddress = overrides.AccountAddress
+	}
+	if overrides.Network != "" {
+		c.Network = overrides.Network
+	}
+	if overrides.Dex != "" {
+		c.Dex = overrides.Dex
+	}
+	if overrides.APIBaseURL != "" {
+		c.APIBaseURL = overrides.APIBaseURL
+	}
+	if overrides.WSBaseURL != "" {
+		c.WSBaseURL = overrides.WSBaseURL
+	}
+	if overrides.Timeouts.Request != 0 {
+		c.Timeouts.Request = overrides.Timeouts.Request
+	}
+	if overrides.Timeouts.Dial != 0 {
+		c.Timeouts.Dial = overrides.Timeouts.Dial
+	}
+	if overrides.RateLimits.RequestsPerSecond != 0 {
+		c.RateLimits.RequestsPerSecond = overrides.RateLimits.RequestsPerSecond
+	}
+	if overrides.RateLimits.Burst != 0 {
+		c.RateLimits.Burst = overrides.RateLimits.Burst
+	}
+	if overrides.MainnetAck {
+		c.MainnetAck = true
+	}
+}
+
+// Validate rejects a Config that would be unsafe or malformed to use:
+// mainnet without an explicit ack, and a PrivateKey/AccountAddress that
+// aren't well-formed 0x-prefixed hex.
+func (c Config) Validate() error {
+	if c.Profile == ProfileMainnet && !c.MainnetAck {
+		return fmt.Errorf("config: profile is mainnet but MainnetAck is false (set HL_MAINNET_ACK=true to confirm)")
+	}
+
+	if c.PrivateKey != "" {
+		if !strings.HasPrefix(c.PrivateKey, "0x") {
+			return fmt.Errorf("config: private key is not 0x-prefixed")
+		}
+		if _, err := c.PrivateKeyECDSA(); err != nil {
+			return fmt.Errorf("config: invalid private key: %w", err)
+		}
+	}
+
+	if c.AccountAddress != "" {
+		if !strings.HasPrefix(c.AccountAddress, "0x") || !common.IsHexAddress(c.AccountAddress) {
+			return fmt.Errorf("config: account address %q is not a valid 0x-prefixed address", c.AccountAddress)
+		}
+	}
+
+	return nil
+}
+
+// PrivateKeyECDSA parses c.PrivateKey as a 0x-prefixed hex-encoded secp256k1
+// key, the format Exchange.NewExchange expects.
+func (c Config) PrivateKeyECDSA() (*ecdsa.PrivateKey, error) {
+	return crypto.HexToECDSA(strings.TrimPrefix(c.PrivateKey, "0x"))
+}
+
+// AccountAddressHex parses c.AccountAddress as a checksummed common.Address.
+func (c Config) AccountAddressHex() (common.Address, error) {
+	if !common.IsHexAddress(c.AccountAddress) {
+		return common.Address{}, fmt.Errorf("config: account address %q is not a valid 0x-prefixed address", c.AccountAddress)
+	}
+	return common.HexToAddress(c.AccountAddress), nil
+}
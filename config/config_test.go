@@ -0,0 +1,73 @@
+package config
+
+import "testing"
+
+func TestLoadDefaultsToTestnetProfile(t *testing.T) {
+	cfg, err := Load("", Config{})
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if cfg.Profile != ProfileTestnet {
+		t.Fatalf("expected profile %q, got %q", ProfileTestnet, cfg.Profile)
+	}
+}
+
+func TestLoadProcessEnvOverridesDefaults(t *testing.T) {
+	t.Setenv("HL_API_URL", "https://example.test")
+
+	cfg, err := Load(ProfileTestnet, Config{})
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if cfg.APIBaseURL != "https://example.test" {
+		t.Fatalf("expected process env to override default API URL, got %q", cfg.APIBaseURL)
+	}
+}
+
+func TestLoadOverridesWinOverProcessEnv(t *testing.T) {
+	t.Setenv("HL_API_URL", "https://example.test")
+
+	cfg, err := Load(ProfileTestnet, Config{APIBaseURL: "https://override.test"})
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if cfg.APIBaseURL != "https://override.test" {
+		t.Fatalf("expected override to win, got %q", cfg.APIBaseURL)
+	}
+}
+
+func TestValidateRejectsMainnetWithoutAck(t *testing.T) {
+	cfg := Config{Profile: ProfileMainnet}
+	if err := cfg.Validate(); err == nil {
+		t.Fatal("expected error for mainnet profile without MainnetAck")
+	}
+
+	cfg.MainnetAck = true
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("expected no error once MainnetAck is true, got %v", err)
+	}
+}
+
+func TestValidateRejectsMalformedPrivateKey(t *testing.T) {
+	cfg := Config{PrivateKey: "not-hex"}
+	if err := cfg.Validate(); err == nil {
+		t.Fatal("expected error for malformed private key")
+	}
+}
+
+func TestValidateRejectsMalformedAccountAddress(t *testing.T) {
+	cfg := Config{AccountAddress: "not-an-address"}
+	if err := cfg.Validate(); err == nil {
+		t.Fatal("expected error for malformed account address")
+	}
+}
+
+func TestMustLoadPanicsOnInvalidConfig(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected MustLoad to panic on invalid config")
+		}
+	}()
+
+	MustLoad(ProfileMainnet, Config{})
+}
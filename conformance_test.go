@@ -0,0 +1,83 @@
+package hyperliquid
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// channelDecoders is the conformance golden gate: every channel the server
+// sends must have an entry here. TestConformanceVectorsAllChannelsCovered
+// fails if a vector names a channel with no registered decoder, and
+// TestConformanceVectors fails if a decoder silently drops a field the
+// server started sending (the dropped field disappears on re-marshal and
+// the round-trip comparison no longer matches the captured frame).
+var channelDecoders = map[string]func() any{
+	ChannelTrades:         func() any { return new(Trades) },
+	ChannelCandle:         func() any { return new(Candle) },
+	ChannelL2Book:         func() any { return new(L2Book) },
+	ChannelBbo:            func() any { return new(Bbo) },
+	ChannelActiveAssetCtx: func() any { return new(ActiveAssetCtx) },
+	ChannelWebData2:       func() any { return new(WebData2) },
+	ChannelOrderUpdates:   func() any { return new(WsOrders) },
+	ChannelUserFills:      func() any { return new(WsOrderFills) },
+	ChannelAllMids:        func() any { return new(AllMids) },
+	ChannelNotification:   func() any { return new(Notification) },
+	ChannelSubResponse:    func() any { return new(SubscriptionResponse) },
+}
+
+func conformanceVectorFiles(t *testing.T) []string {
+	t.Helper()
+
+	files, err := filepath.Glob(filepath.Join("testdata", "vectors", "*.json"))
+	require.NoError(t, err)
+	require.NotEmpty(t, files, "expected at least one conformance vector")
+
+	return files
+}
+
+func loadConformanceVector(t *testing.T, path string) wsMessage {
+	t.Helper()
+
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var msg wsMessage
+	require.NoError(t, json.Unmarshal(raw, &msg))
+
+	return msg
+}
+
+func TestConformanceVectorsAllChannelsCovered(t *testing.T) {
+	for _, path := range conformanceVectorFiles(t) {
+		msg := loadConformanceVector(t, path)
+
+		_, ok := channelDecoders[msg.Channel]
+		assert.True(t, ok, "%s: no decoder registered for channel %q", path, msg.Channel)
+	}
+}
+
+func TestConformanceVectors(t *testing.T) {
+	for _, path := range conformanceVectorFiles(t) {
+		path := path
+
+		t.Run(filepath.Base(path), func(t *testing.T) {
+			msg := loadConformanceVector(t, path)
+
+			newTarget, ok := channelDecoders[msg.Channel]
+			require.True(t, ok, "no decoder registered for channel %q", msg.Channel)
+
+			target := newTarget()
+			require.NoError(t, json.Unmarshal(msg.Data, target))
+
+			roundTripped, err := json.Marshal(target)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, string(msg.Data), string(roundTripped))
+		})
+	}
+}
@@ -0,0 +1,306 @@
+package hyperliquid
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// decimalScale is the number of decimal places a Decimal's mantissa is
+// scaled by. Hyperliquid's wire format never needs more than 8 decimal
+// places (floatToWire's historical %.8f), so a single fixed scale is
+// enough for both price and size arithmetic.
+const decimalScale = 8
+
+var decimalScaleFactor = new(big.Int).Exp(big.NewInt(10), big.NewInt(decimalScale), nil)
+
+// Decimal is a base-10 fixed-point number with decimalScale decimal
+// places, backed by mantissa = value * 10^decimalScale. Unlike float64,
+// every value a Decimal can hold round-trips through Wire() exactly:
+// there is no %.8f-vs-original comparison to spuriously reject a
+// legitimate price like 0.1+0.2, and no silent precision loss at
+// BTC-scale notionals the way math.Floor/math.Pow lose bits.
+type Decimal struct {
+	mantissa *big.Int
+}
+
+// DecimalFromString parses an exact decimal string (e.g. "0.30000000" or
+// "43250.5") into a Decimal. Unlike DecimalFromFloat, this never goes
+// through float64 and so never loses precision.
+func DecimalFromString(s string) (Decimal, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Decimal{}, fmt.Errorf("decimal: empty string")
+	}
+
+	neg := false
+	switch s[0] {
+	case '-':
+		neg = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+
+	intPart, fracPart, hasFrac := s, "", false
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		intPart, fracPart, hasFrac = s[:i], s[i+1:], true
+	}
+	if intPart == "" {
+		intPart = "0"
+	}
+	if hasFrac && len(fracPart) > decimalScale {
+		return Decimal{}, fmt.Errorf("decimal: %q has more than %d decimal places", s, decimalScale)
+	}
+	fracPart = fracPart + strings.Repeat("0", decimalScale-len(fracPart))
+
+	mantissa, ok := new(big.Int).SetString(intPart+fracPart, 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("decimal: invalid number %q", s)
+	}
+	if neg {
+		mantissa.Neg(mantissa)
+	}
+
+	return Decimal{mantissa: mantissa}, nil
+}
+
+// DecimalFromFloat converts f to a Decimal via its %.8f decimal
+// expansion. This is an explicit, documented lossy conversion: f may
+// already carry float64 representation error (e.g. 0.1+0.2 ==
+// 0.30000000000000004) that rounding to decimalScale places papers over,
+// and any precision beyond decimalScale places is truncated. Prefer
+// DecimalFromString when the exact decimal value is known up front.
+func DecimalFromFloat(f float64) (Decimal, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return Decimal{}, fmt.Errorf("decimal: cannot convert non-finite float %v", f)
+	}
+	return DecimalFromString(strconv.FormatFloat(f, 'f', decimalScale, 64))
+}
+
+// Float64 returns d as a float64. Documented lossy: a Decimal can carry
+// more significant digits than float64's 53-bit mantissa represents
+// exactly.
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.Wire(), 64)
+	return f
+}
+
+// IsZero reports whether d is exactly zero.
+func (d Decimal) IsZero() bool {
+	return d.mantissa == nil || d.mantissa.Sign() == 0
+}
+
+// Sign returns -1, 0, or 1 depending on the sign of d.
+func (d Decimal) Sign() int {
+	if d.mantissa == nil {
+		return 0
+	}
+	return d.mantissa.Sign()
+}
+
+// mantissaOrZero returns d.mantissa, or a freshly allocated zero if d is
+// the nil-mantissa zero value, so Decimal{}/Price{}/Size{} - what this
+// package's own error paths return (see exchange_rounding.go) - stay
+// usable in arithmetic instead of panicking on first use, matching
+// IsZero/Sign/Wire, which already treat a nil mantissa as zero.
+func mantissaOrZero(d Decimal) *big.Int {
+	if d.mantissa == nil {
+		return new(big.Int)
+	}
+	return d.mantissa
+}
+
+// Neg returns -d.
+func (d Decimal) Neg() Decimal {
+	return Decimal{mantissa: new(big.Int).Neg(mantissaOrZero(d))}
+}
+
+// Abs returns the absolute value of d.
+func (d Decimal) Abs() Decimal {
+	return Decimal{mantissa: new(big.Int).Abs(mantissaOrZero(d))}
+}
+
+// Add returns d + other.
+func (d Decimal) Add(other Decimal) Decimal {
+	return Decimal{mantissa: new(big.Int).Add(mantissaOrZero(d), mantissaOrZero(other))}
+}
+
+// Sub returns d - other.
+func (d Decimal) Sub(other Decimal) Decimal {
+	return Decimal{mantissa: new(big.Int).Sub(mantissaOrZero(d), mantissaOrZero(other))}
+}
+
+// Mul returns d * other, rounded to decimalScale places (the product of
+// two decimalScale-scaled mantissas has 2*decimalScale places, which is
+// rescaled back down).
+func (d Decimal) Mul(other Decimal) Decimal {
+	product := new(big.Int).Mul(mantissaOrZero(d), mantissaOrZero(other))
+	return Decimal{mantissa: roundBigDiv(product, decimalScaleFactor)}
+}
+
+// Div returns d / other, rounded to decimalScale places. Div panics if
+// other is zero, the same way float64 division by a Decimal zero would
+// be a caller bug rather than a recoverable error.
+func (d Decimal) Div(other Decimal) Decimal {
+	if other.IsZero() {
+		panic("hyperliquid: decimal division by zero")
+	}
+	numerator := new(big.Int).Mul(mantissaOrZero(d), decimalScaleFactor)
+	return Decimal{mantissa: roundBigDiv(numerator, mantissaOrZero(other))}
+}
+
+// Cmp returns -1, 0, or 1 depending on whether d is less than, equal to,
+// or greater than other.
+func (d Decimal) Cmp(other Decimal) int {
+	return mantissaOrZero(d).Cmp(mantissaOrZero(other))
+}
+
+// roundBigDiv computes round(num/den), rounding half away from zero the
+// same way math.Round does for float64.
+func roundBigDiv(num, den *big.Int) *big.Int {
+	quo, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if rem.Sign() == 0 {
+		return quo
+	}
+
+	twice := new(big.Int).Abs(rem)
+	twice.Lsh(twice, 1)
+	if twice.Cmp(new(big.Int).Abs(den)) >= 0 {
+		if (num.Sign() < 0) != (den.Sign() < 0) {
+			quo.Sub(quo, big.NewInt(1))
+		} else {
+			quo.Add(quo, big.NewInt(1))
+		}
+	}
+	return quo
+}
+
+// RoundToDecimals rounds d to the given number of decimal places,
+// rounding half away from zero.
+func (d Decimal) RoundToDecimals(decimals int) Decimal {
+	if decimals >= decimalScale {
+		return d
+	}
+	if decimals < 0 {
+		decimals = 0
+	}
+	drop := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimalScale-decimals)), nil)
+	rounded := roundBigDiv(mantissaOrZero(d), drop)
+	rounded.Mul(rounded, drop)
+	return Decimal{mantissa: rounded}
+}
+
+// RoundToSignificantFigures rounds d to sigFigs significant figures, the
+// decimal-exact replacement for the old float64 roundToSignificantFigures
+// (which used math.Floor/math.Pow and silently lost precision at
+// BTC-scale notionals). If d's integer part alone already has at least
+// sigFigs digits, d is rounded to an integer (matching Hyperliquid's own
+// rule that the whole part is never truncated away).
+func (d Decimal) RoundToSignificantFigures(sigFigs int) Decimal {
+	if d.IsZero() {
+		return d
+	}
+
+	abs := new(big.Int).Abs(d.mantissa)
+	integerPart := new(big.Int).Quo(abs, decimalScaleFactor)
+
+	numIntegerDigits := len(integerPart.String())
+	if integerPart.Sign() == 0 {
+		numIntegerDigits = 1
+	}
+
+	if numIntegerDigits >= sigFigs {
+		return d.RoundToDecimals(0)
+	}
+
+	return d.RoundToDecimals(sigFigs - numIntegerDigits)
+}
+
+// Wire formats d the same way floatToWire formats a float64: fixed to
+// decimalScale decimal places, trailing zeros and a trailing decimal
+// point trimmed, "-0" normalized to "0". Unlike floatToWire, this never
+// rejects a value: d already carries no more precision than the wire
+// format allows.
+func (d Decimal) Wire() string {
+	if d.mantissa == nil {
+		return "0"
+	}
+
+	neg := d.mantissa.Sign() < 0
+	abs := new(big.Int).Abs(d.mantissa)
+
+	digits := abs.String()
+	if len(digits) <= decimalScale {
+		digits = strings.Repeat("0", decimalScale-len(digits)+1) + digits
+	}
+	intPart := digits[:len(digits)-decimalScale]
+	fracPart := digits[len(digits)-decimalScale:]
+
+	result := intPart
+	fracPart = strings.TrimRight(fracPart, "0")
+	if fracPart != "" {
+		result += "." + fracPart
+	}
+
+	if neg && result != "0" {
+		result = "-" + result
+	}
+	return result
+}
+
+// String implements fmt.Stringer by returning d.Wire().
+func (d Decimal) String() string {
+	return d.Wire()
+}
+
+// Price is a Decimal tagged as representing an order price, pulled from
+// Meta/SpotMeta's documented pxDecimals rule. Build one with
+// PriceFromString or PriceFromFloat.
+type Price struct{ Decimal }
+
+// PriceFromString parses an exact decimal price string.
+func PriceFromString(s string) (Price, error) {
+	d, err := DecimalFromString(s)
+	if err != nil {
+		return Price{}, fmt.Errorf("price: %w", err)
+	}
+	return Price{d}, nil
+}
+
+// PriceFromFloat converts f to a Price via Decimal's documented lossy
+// float conversion. Prefer PriceFromString when the exact decimal value
+// is known (e.g. parsed from user input rather than computed).
+func PriceFromFloat(f float64) (Price, error) {
+	d, err := DecimalFromFloat(f)
+	if err != nil {
+		return Price{}, fmt.Errorf("price: %w", err)
+	}
+	return Price{d}, nil
+}
+
+// Size is a Decimal tagged as representing an order size, pulled from
+// Meta/SpotMeta's documented szDecimals rule. Build one with
+// SizeFromString or SizeFromFloat.
+type Size struct{ Decimal }
+
+// SizeFromString parses an exact decimal size string.
+func SizeFromString(s string) (Size, error) {
+	d, err := DecimalFromString(s)
+	if err != nil {
+		return Size{}, fmt.Errorf("size: %w", err)
+	}
+	return Size{d}, nil
+}
+
+// SizeFromFloat converts f to a Size via Decimal's documented lossy float
+// conversion. Prefer SizeFromString when the exact decimal value is known.
+func SizeFromFloat(f float64) (Size, error) {
+	d, err := DecimalFromFloat(f)
+	if err != nil {
+		return Size{}, fmt.Errorf("size: %w", err)
+	}
+	return Size{d}, nil
+}
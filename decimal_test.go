@@ -0,0 +1,92 @@
+package hyperliquid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecimalFromFloatAvoidsRoundTripTrap(t *testing.T) {
+	// 0.1+0.2 == 0.30000000000000004 in float64; floatToWire's old
+	// round-trip check could reject values like this.
+	d, err := DecimalFromFloat(0.1 + 0.2)
+	require.NoError(t, err)
+	assert.Equal(t, "0.3", d.Wire())
+}
+
+func TestDecimalFromStringRejectsExcessPrecision(t *testing.T) {
+	_, err := DecimalFromString("43251.123456789012")
+	assert.Error(t, err)
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	ten, err := DecimalFromString("10")
+	require.NoError(t, err)
+	three, err := DecimalFromString("3")
+	require.NoError(t, err)
+
+	assert.Equal(t, "3.33333333", ten.Div(three).Wire())
+	assert.Equal(t, "13", ten.Add(three).Wire())
+	assert.Equal(t, "7", ten.Sub(three).Wire())
+	assert.Equal(t, "30", ten.Mul(three).Wire())
+}
+
+func TestDecimalRoundToSignificantFigures(t *testing.T) {
+	tests := []struct {
+		name     string
+		price    string
+		sigFigs  int
+		expected string
+	}{
+		{"keeps all digits", "123.456789", 9, "123.456789"},
+		{"keeps 2 of the 3 decimal places", "123.453", 5, "123.45"},
+		{"fraction below 1 counts 0 as a significant figure", "0.12", 2, "0.1"},
+		{"integer part already has more sig figs than requested", "110454", 5, "110454"},
+		{"sigFigs 0 still keeps the whole integer part", "24", 0, "24"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d, err := DecimalFromString(test.price)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, d.RoundToSignificantFigures(test.sigFigs).Wire())
+		})
+	}
+}
+
+func TestDecimalWireTrimsTrailingZerosAndNormalizesNegativeZero(t *testing.T) {
+	zero, err := DecimalFromFloat(0)
+	require.NoError(t, err)
+	assert.Equal(t, "0", zero.Wire())
+
+	// -0.000000001 formats as "-0.00000000" at decimalScale places.
+	negZero, err := DecimalFromFloat(-0.000000001)
+	require.NoError(t, err)
+	assert.Equal(t, "0", negZero.Wire())
+
+	tinyNeg, err := DecimalFromFloat(-0.00000001)
+	require.NoError(t, err)
+	assert.Equal(t, "-0.00000001", tinyNeg.Wire())
+}
+
+// TestDecimalZeroValueArithmeticDoesNotPanic guards Decimal{}/Price{}/
+// Size{} - the nil-mantissa zero value this package's own error paths
+// return (see exchange_rounding.go) - against panicking on first
+// arithmetic use, the way a usable Go zero value should.
+func TestDecimalZeroValueArithmeticDoesNotPanic(t *testing.T) {
+	var zero Decimal
+	one, err := DecimalFromString("1")
+	require.NoError(t, err)
+
+	assert.NotPanics(t, func() {
+		assert.Equal(t, "0", zero.Neg().Wire())
+		assert.Equal(t, "0", zero.Abs().Wire())
+		assert.Equal(t, "1", zero.Add(one).Wire())
+		assert.Equal(t, "-1", zero.Sub(one).Wire())
+		assert.Equal(t, "0", zero.Mul(one).Wire())
+		assert.Equal(t, "0", zero.Div(one).Wire())
+		assert.Equal(t, -1, zero.Cmp(one))
+		assert.Equal(t, "0", zero.RoundToDecimals(2).Wire())
+	})
+}
@@ -17,15 +17,6 @@ func (e APIError) Error() string {
 	return fmt.Sprintf("API error %d: %s", e.Code, e.Message)
 }
 
-type ValidationError struct {
-	Field   string
-	Message string
-}
-
-func (e ValidationError) Error() string {
-	return fmt.Sprintf("validation error on field %s: %s", e.Field, e.Message)
-}
-
 // IsWalletDoesNotExistError checks if the error is a "wallet does not exist" error from the API
 func IsWalletDoesNotExistError(err error) bool {
 	if err == nil {
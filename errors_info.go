@@ -0,0 +1,95 @@
+package hyperliquid
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Sentinel errors for Info/transport-level failures. client.post and
+// Info's endpoint methods wrap failures in InvalidParameterError,
+// InfoAPIError, or NetworkError carrying one of these as their Unwrap
+// target, so callers can errors.Is/errors.As instead of matching
+// substrings of the error's message. ErrRateLimited is shared with
+// errors_order.go's order-lifecycle classification, since both describe
+// the same server-side condition.
+var (
+	// ErrInvalidParameter is returned when a caller-supplied argument
+	// fails a pre-flight check (e.g. an empty dex name) before any
+	// request is sent. Build one with ValidationError.
+	ErrInvalidParameter = errors.New("hyperliquid: invalid parameter")
+
+	// ErrAPIError is returned when the server responds with a non-2xx
+	// status other than 429 (see ErrRateLimited).
+	ErrAPIError = errors.New("hyperliquid: api error")
+
+	// ErrNetwork is returned when a request could not be marshaled, sent,
+	// or read back, as opposed to the server responding with an error.
+	ErrNetwork = errors.New("hyperliquid: network error")
+)
+
+// InvalidParameterError is returned when a pre-flight parameter check
+// fails. Build one with ValidationError rather than constructing it
+// directly.
+type InvalidParameterError struct {
+	// Param is the name of the failing parameter, e.g. "dex".
+	Param string
+	// Reason describes why Param failed validation.
+	Reason string
+}
+
+func (e *InvalidParameterError) Error() string {
+	return fmt.Sprintf("invalid parameter %s: %s", e.Param, e.Reason)
+}
+
+func (e *InvalidParameterError) Unwrap() error {
+	return ErrInvalidParameter
+}
+
+// ValidationError builds an *InvalidParameterError reporting that param
+// failed a pre-flight check for reason. Endpoint methods use this instead
+// of ad-hoc fmt.Errorf so callers can assert via errors.Is(err,
+// ErrInvalidParameter) instead of matching the error's message.
+func ValidationError(param, reason string) error {
+	return &InvalidParameterError{Param: param, Reason: reason}
+}
+
+// InfoAPIError is returned when the server responds with a non-2xx
+// status. Status is the HTTP status code; Code and Message are the
+// Hyperliquid response body's "code"/"msg" fields, when the body parsed
+// as one.
+type InfoAPIError struct {
+	Status  int
+	Code    int
+	Message string
+}
+
+func (e *InfoAPIError) Error() string {
+	return fmt.Sprintf("api error (status %d, code %d): %s", e.Status, e.Code, e.Message)
+}
+
+// Unwrap reports ErrRateLimited for a 429 response and ErrAPIError
+// otherwise, so callers can distinguish rate-limiting from other
+// server-side failures with errors.Is.
+func (e *InfoAPIError) Unwrap() error {
+	if e.Status == http.StatusTooManyRequests {
+		return ErrRateLimited
+	}
+	return ErrAPIError
+}
+
+// NetworkError is returned when a request could not be marshaled, sent,
+// or read back. Unwrap exposes both ErrNetwork and the underlying cause,
+// so errors.Is(err, ErrNetwork) succeeds alongside errors.Is/As against
+// whatever transport error caused it.
+type NetworkError struct {
+	Err error
+}
+
+func (e *NetworkError) Error() string {
+	return fmt.Sprintf("network error: %s", e.Err)
+}
+
+func (e *NetworkError) Unwrap() []error {
+	return []error{ErrNetwork, e.Err}
+}
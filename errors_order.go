@@ -0,0 +1,107 @@
+package hyperliquid
+
+import (
+	"errors"
+	"strings"
+)
+
+// Sentinel errors for order-lifecycle failures. Exchange.Order, Cancel,
+// CancelByCloid, ModifyOrder, and BulkOrders/BulkCancel/BulkCancelByCloids
+// wrap the server's raw error text in an *OrderAPIError carrying one of these as
+// its Sentinel, so callers can errors.Is/errors.As instead of matching
+// substrings of the server's human-readable message.
+var (
+	// ErrOrderNotFound is returned when the server reports an order as
+	// never placed, already canceled, or already filled.
+	ErrOrderNotFound = errors.New("hyperliquid: order not found, already canceled, or filled")
+
+	// ErrBelowMinNotional is returned when an order's price*size falls
+	// below Hyperliquid's minimum order value.
+	ErrBelowMinNotional = errors.New("hyperliquid: order below minimum notional value")
+
+	// ErrInsufficientMargin is returned when the account lacks the margin
+	// required to place or modify an order.
+	ErrInsufficientMargin = errors.New("hyperliquid: insufficient margin")
+
+	// ErrPostOnlyWouldCross is returned when an ALO/post-only order would
+	// have matched immediately against the book.
+	ErrPostOnlyWouldCross = errors.New("hyperliquid: post-only order would cross the book")
+
+	// ErrReduceOnlyWouldIncrease is returned when a reduce-only order
+	// would increase the position instead of reducing it.
+	ErrReduceOnlyWouldIncrease = errors.New("hyperliquid: reduce-only order would increase position")
+
+	// ErrInvalidTif is returned when the order's time-in-force is not
+	// valid for its order type.
+	ErrInvalidTif = errors.New("hyperliquid: invalid time-in-force")
+
+	// ErrRateLimited is returned when the server rejects the request for
+	// exceeding its rate limit.
+	ErrRateLimited = errors.New("hyperliquid: rate limited")
+
+	// ErrAgentNotApproved is returned when the signing agent wallet has
+	// not been approved for the account.
+	ErrAgentNotApproved = errors.New("hyperliquid: agent not approved")
+)
+
+// OrderAPIError wraps a server-reported order-lifecycle failure, preserving the
+// raw message (for logging) alongside the sentinel it was classified as
+// (for errors.Is/errors.As). Unwrap returns Sentinel.
+type OrderAPIError struct {
+	// Sentinel is the typed error this message was classified as, one of
+	// the Err* sentinels in this file, or nil if the message didn't match
+	// any known pattern.
+	Sentinel error
+	// Raw is the exact, unmodified error text the server returned.
+	Raw string
+}
+
+func (e *OrderAPIError) Error() string {
+	if e.Raw == "" {
+		return e.Sentinel.Error()
+	}
+	return e.Raw
+}
+
+func (e *OrderAPIError) Unwrap() error {
+	return e.Sentinel
+}
+
+// classifyOrderError inspects raw, the server's human-readable error text
+// for an order-lifecycle action, and wraps it in an *OrderAPIError carrying the
+// matching sentinel. If raw matches none of the known patterns, the
+// returned *OrderAPIError still carries raw for logging, with a nil Sentinel.
+func classifyOrderError(raw string) error {
+	if raw == "" {
+		return nil
+	}
+
+	lower := strings.ToLower(raw)
+
+	var sentinel error
+	switch {
+	case strings.Contains(lower, "never placed") ||
+		strings.Contains(lower, "already canceled") ||
+		strings.Contains(lower, "already filled"):
+		sentinel = ErrOrderNotFound
+	case strings.Contains(lower, "minimum value"):
+		sentinel = ErrBelowMinNotional
+	case strings.Contains(lower, "insufficient margin") ||
+		strings.Contains(lower, "margin is not enough"):
+		sentinel = ErrInsufficientMargin
+	case strings.Contains(lower, "would have immediately matched") ||
+		strings.Contains(lower, "post only"):
+		sentinel = ErrPostOnlyWouldCross
+	case strings.Contains(lower, "reduce only"):
+		sentinel = ErrReduceOnlyWouldIncrease
+	case strings.Contains(lower, "tif"):
+		sentinel = ErrInvalidTif
+	case strings.Contains(lower, "rate limit"):
+		sentinel = ErrRateLimited
+	case strings.Contains(lower, "agent") && strings.Contains(lower, "not registered") ||
+		strings.Contains(lower, "agent not approved"):
+		sentinel = ErrAgentNotApproved
+	}
+
+	return &OrderAPIError{Sentinel: sentinel, Raw: raw}
+}
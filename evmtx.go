@@ -0,0 +1,111 @@
+package hyperliquid
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// EVMTxType selects which Ethereum transaction envelope to build for a
+// HyperEVM bridge action (deposit/withdrawal, contract call posted through
+// the exchange).
+type EVMTxType int
+
+const (
+	// EVMTxTypeLegacy builds an EIP-155 legacy transaction.
+	EVMTxTypeLegacy EVMTxType = iota
+	// EVMTxTypeAccessList builds a type-0x01 EIP-2930 access-list transaction.
+	EVMTxTypeAccessList
+	// EVMTxTypeDynamicFee builds a type-0x02 EIP-1559 dynamic-fee transaction.
+	EVMTxTypeDynamicFee
+)
+
+// EVMTxRequest describes a HyperEVM transaction prior to signing. Which
+// fields are consumed depends on the EVMTxType passed to NewEVMTransaction:
+// legacy and access-list transactions use GasFeeCap as the flat gas price,
+// while dynamic-fee transactions use GasTipCap/GasFeeCap as
+// maxPriorityFeePerGas/maxFeePerGas.
+type EVMTxRequest struct {
+	ChainID    *big.Int
+	Nonce      uint64
+	GasTipCap  *big.Int
+	GasFeeCap  *big.Int
+	GasLimit   uint64
+	To         *common.Address
+	Value      *big.Int
+	Data       []byte
+	AccessList types.AccessList
+}
+
+// NewEVMTransaction builds an unsigned *types.Transaction for the given
+// envelope type. The returned transaction is ready to be passed to
+// SignEVMTransaction.
+func NewEVMTransaction(txType EVMTxType, req EVMTxRequest) *types.Transaction {
+	value := req.Value
+	if value == nil {
+		value = big.NewInt(0)
+	}
+
+	switch txType {
+	case EVMTxTypeAccessList:
+		return types.NewTx(&types.AccessListTx{
+			ChainID:    req.ChainID,
+			Nonce:      req.Nonce,
+			GasPrice:   req.GasFeeCap,
+			Gas:        req.GasLimit,
+			To:         req.To,
+			Value:      value,
+			Data:       req.Data,
+			AccessList: req.AccessList,
+		})
+	case EVMTxTypeDynamicFee:
+		return types.NewTx(&types.DynamicFeeTx{
+			ChainID:    req.ChainID,
+			Nonce:      req.Nonce,
+			GasTipCap:  req.GasTipCap,
+			GasFeeCap:  req.GasFeeCap,
+			Gas:        req.GasLimit,
+			To:         req.To,
+			Value:      value,
+			Data:       req.Data,
+			AccessList: req.AccessList,
+		})
+	default:
+		return types.NewTx(&types.LegacyTx{
+			Nonce:    req.Nonce,
+			GasPrice: req.GasFeeCap,
+			Gas:      req.GasLimit,
+			To:       req.To,
+			Value:    value,
+			Data:     req.Data,
+		})
+	}
+}
+
+// LatestSignerForChainID returns the most recent go-ethereum signer
+// implementation for chainID, mirroring go-ethereum's own
+// types.LatestSignerForChainID. It is exposed here so callers never need to
+// import go-ethereum's types package directly to sign HyperEVM transactions.
+func LatestSignerForChainID(chainID *big.Int) types.Signer {
+	return types.LatestSignerForChainID(chainID)
+}
+
+// SignEVMTransaction signs tx for chainID using privateKey and returns the
+// signed transaction. The result can be RLP-encoded via tx.MarshalBinary()
+// and decoded back with (*types.Transaction).UnmarshalBinary.
+func SignEVMTransaction(
+	privateKey *ecdsa.PrivateKey,
+	chainID *big.Int,
+	tx *types.Transaction,
+) (*types.Transaction, error) {
+	signer := LatestSignerForChainID(chainID)
+	return types.SignTx(tx, signer, privateKey)
+}
+
+// EVMTransactionSender recovers the sender address of a signed HyperEVM
+// transaction under the given chain ID.
+func EVMTransactionSender(chainID *big.Int, tx *types.Transaction) (common.Address, error) {
+	return types.Sender(LatestSignerForChainID(chainID), tx)
+}
@@ -0,0 +1,69 @@
+package hyperliquid
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignEVMTransaction(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	to := common.HexToAddress("0x000000000000000000000000000000000000aa")
+	chainID := big.NewInt(999)
+
+	tests := []struct {
+		name   string
+		txType EVMTxType
+	}{
+		{"legacy", EVMTxTypeLegacy},
+		{"access list", EVMTxTypeAccessList},
+		{"dynamic fee", EVMTxTypeDynamicFee},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := EVMTxRequest{
+				ChainID:   chainID,
+				Nonce:     7,
+				GasTipCap: big.NewInt(2_000_000_000),
+				GasFeeCap: big.NewInt(30_000_000_000),
+				GasLimit:  21000,
+				To:        &to,
+				Value:     big.NewInt(1),
+				Data:      []byte{0xde, 0xad},
+			}
+
+			unsigned := NewEVMTransaction(tt.txType, req)
+			signed, err := SignEVMTransaction(privateKey, chainID, unsigned)
+			require.NoError(t, err)
+
+			sender, err := EVMTransactionSender(chainID, signed)
+			require.NoError(t, err)
+			assert.Equal(t, crypto.PubkeyToAddress(privateKey.PublicKey), sender)
+
+			// Round-trip through RLP via MarshalBinary/UnmarshalBinary.
+			raw, err := signed.MarshalBinary()
+			require.NoError(t, err)
+
+			var decoded types.Transaction
+			require.NoError(t, decoded.UnmarshalBinary(raw))
+			assert.Equal(t, signed.Hash(), decoded.Hash())
+
+			decodedSender, err := EVMTransactionSender(chainID, &decoded)
+			require.NoError(t, err)
+			assert.Equal(t, sender, decodedSender)
+		})
+	}
+}
+
+func TestLatestSignerForChainID(t *testing.T) {
+	signer := LatestSignerForChainID(big.NewInt(1))
+	assert.Equal(t, types.LatestSignerForChainID(big.NewInt(1)), signer)
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/joho/godotenv"
+	"github.com/sonirico/go-hyperliquid/cienv"
 )
 
 // loadEnvClean loads environment variables from the specified .env file(s)
@@ -31,29 +32,11 @@ func loadEnvClean(filenames ...string) error {
 	return godotenv.Overload(filenames...)
 }
 
-// isCI detects if running in a CI environment
+// isCI detects if running in a CI environment. It defers to cienv.IsCI for
+// every provider it recognizes, plus the generic CI=true convention several
+// providers (and ad-hoc CI setups) also set.
 func isCI() bool {
-	// GitHub Actions
-	if os.Getenv("GITHUB_ACTIONS") == "true" || os.Getenv("CI") == "true" {
-		return true
-	}
-	// GitLab CI
-	if os.Getenv("GITLAB_CI") == "true" {
-		return true
-	}
-	// CircleCI
-	if os.Getenv("CIRCLECI") == "true" {
-		return true
-	}
-	// Travis CI
-	if os.Getenv("TRAVIS") == "true" {
-		return true
-	}
-	// Jenkins
-	if os.Getenv("JENKINS_URL") != "" {
-		return true
-	}
-	return false
+	return os.Getenv("CI") == "true" || cienv.IsCI()
 }
 
 // clearHyperliquidEnv removes all HL_* prefixed environment variables.
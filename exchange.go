@@ -4,19 +4,80 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"encoding/json"
+	"sync"
 	"sync/atomic"
 	"time"
+
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/sonirico/go-hyperliquid/cienv"
 )
 
 type Exchange struct {
 	debug        bool
 	client       *Client
 	privateKey   *ecdsa.PrivateKey
+	signer       Signer
 	vault        string
 	accountAddr  string
 	info         *Info
 	expiresAfter *int64
 	lastNonce    atomic.Int64
+	nonceManager *NonceManager
+	bridgeRouter *BridgeRouter
+
+	// builderAddr/builderFeeRate record the most recent successful
+	// ApproveBuilderFee call so QuoteMarketOrder can factor the builder
+	// fee into its breakdown without the caller having to pass it again.
+	builderAddr    string
+	builderFeeRate string
+
+	// idempotencyStore, when set via WithIdempotency, makes
+	// postActionIdempotent persist and replay (nonce, action-hash,
+	// status) tuples instead of posting directly.
+	idempotencyStore      IdempotencyStore
+	idempotencyChecker    ActionStatusChecker
+	idempotencyMaxRetries int
+	idempotencyBackoff    time.Duration
+
+	// actionMiddleware wraps every Do call; see Exchange.Use.
+	actionMiddleware []ActionMiddleware
+
+	// metrics, set via ExchangeOptMetrics, receives RPC and builder-dex
+	// gauge instrumentation for both this Exchange's client and info; see
+	// metrics.go. Defaults to a no-op sink.
+	metrics MetricsSink
+
+	// dryRunSink and offlineSigner, when set via ExchangeOptDryRun or
+	// ExchangeOptOfflineSigner, make postAction sign but never POST an
+	// action; see exchange_dryrun.go.
+	dryRunSink    func(SignedAction)
+	offlineSigner bool
+
+	// trackedActions backs SubscribeActions; see exchange_receipt.go.
+	trackedActionsMu sync.Mutex
+	trackedActions   map[int64]trackedAction
+
+	// positionsCache backs Validate's local ReduceOnly check; see
+	// exchange_validate.go. Populated by RefreshPositionsCache, never by
+	// Validate itself, so validation stays a pure, network-free check.
+	positionsCacheMu sync.RWMutex
+	positionsCache   map[string]float64
+
+	// roundingMode controls how RoundPrice/RoundSize, and order/modify
+	// action construction, round Price/Size to the asset's allowed tick
+	// and lot; see exchange_rounding.go. Defaults to RoundNearest.
+	roundingMode RoundingMode
+
+	// OnBeforeSign, if set, is called with the fully-populated TypedData
+	// and its EIP-712 digest immediately before every action is signed.
+	// Use it for audit logging or to surface exactly what a hardware
+	// wallet will display to the user.
+	OnBeforeSign func(td apitypes.TypedData, digest [32]byte)
+
+	// ciInfo, set via ExchangeOptCIMetadata, tags orders placed by this
+	// Exchange with a deterministic cloid prefix derived from
+	// ciInfo.CommitSHA; see exchange_ci.go. Zero value disables tagging.
+	ciInfo cienv.BuildInfo
 }
 
 func NewExchange(
@@ -37,6 +98,10 @@ func NewExchange(
 		opt.Apply(ex)
 	}
 
+	if ex.metrics == nil {
+		ex.metrics = noopMetricsSink{}
+	}
+
 	var (
 		clientOpts []ClientOpt
 		infoOpts   []InfoOpt
@@ -45,6 +110,8 @@ func NewExchange(
 		clientOpts = append(clientOpts, ClientOptDebugMode())
 		infoOpts = append(infoOpts, InfoOptDebugMode())
 	}
+	clientOpts = append(clientOpts, ClientOptMetrics(ex.metrics))
+	infoOpts = append(infoOpts, WithMetrics(ex.metrics))
 
 	ex.client = NewClient(baseURL, clientOpts...)
 	ex.info = NewInfo(baseURL, true, meta, spotMeta, infoOpts...)
@@ -55,7 +122,20 @@ func NewExchange(
 // nextNonce returns either the current timestamp in milliseconds or incremented by one to prevent duplicates
 // Nonces must be within (T - 2 days, T + 1 day), where T is the unix millisecond timestamp on the block of the transaction.
 // See https://hyperliquid.gitbook.io/hyperliquid-docs/for-developers/api/nonces-and-api-wallets#hyperliquid-nonces
+//
+// When an ExchangeOptNonceManager was provided, nonce allocation instead
+// goes through the NonceManager so it persists across restarts and
+// supports reservation/rollback for concurrent submission.
 func (e *Exchange) nextNonce() int64 {
+	if e.nonceManager != nil {
+		nonce, err := e.nonceManager.Next(e.signerOrDefault().Address().Hex())
+		if err == nil {
+			return nonce
+		}
+		// Fall through to the in-memory fallback below if the manager's
+		// store is unavailable, rather than blocking order submission.
+	}
+
 	// it's possible that at exactly the same time a nextNonce is requested
 	for {
 		last := e.lastNonce.Load()
@@ -78,12 +158,20 @@ func (e *Exchange) SetLastNonce(n int64) {
 	e.lastNonce.Store(n)
 }
 
+// signerOrDefault returns the Signer configured via ExchangeOptSigner, or a
+// LocalSigner wrapping e.privateKey when none was set.
+func (e *Exchange) signerOrDefault() Signer {
+	if e.signer != nil {
+		return e.signer
+	}
+	return NewLocalSigner(e.privateKey)
+}
+
 // executeAction executes an action and unmarshals the response into the given result
 func (e *Exchange) executeAction(ctx context.Context, action, result any) error {
 	nonce := e.nextNonce()
 
-	sig, err := SignL1Action(
-		e.privateKey,
+	typedData, digest, err := BuildL1TypedData(
 		action,
 		e.vault,
 		nonce,
@@ -93,6 +181,14 @@ func (e *Exchange) executeAction(ctx context.Context, action, result any) error
 	if err != nil {
 		return err
 	}
+	if e.OnBeforeSign != nil {
+		e.OnBeforeSign(typedData, digest)
+	}
+
+	sig, err := signInnerWithSigner(ctx, e.signerOrDefault(), typedData)
+	if err != nil {
+		return err
+	}
 
 	resp, err := e.postAction(ctx, action, sig, nonce)
 	if err != nil {
@@ -138,5 +234,9 @@ func (e *Exchange) postAction(
 		payload["expiresAfter"] = *e.expiresAfter
 	}
 
+	if e.dryRunSink != nil || e.offlineSigner {
+		return e.dryRunAction(action, signature, nonce, payload)
+	}
+
 	return e.client.post(ctx, "/exchange", payload)
 }
@@ -0,0 +1,155 @@
+package hyperliquid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// maxBatchParallelism bounds how many actions within one batch are signed
+// and posted concurrently, so a large batch does not open hundreds of
+// simultaneous HTTP requests against the exchange.
+const maxBatchParallelism = 8
+
+// BatchActionFunc builds one action given the nonce reserved for it. Most
+// L1 actions carry their own nonce alongside the top-level one (e.g.
+// UsdTransferAction.Time must equal it), so the batch builder defers
+// construction until nonces have been reserved rather than accepting
+// pre-built action values.
+type BatchActionFunc func(nonce int64) any
+
+// BatchBuilder accumulates actions to submit as a single coordinated
+// batch. Create one with Exchange.Batch.
+type BatchBuilder struct {
+	exchange *Exchange
+	builders []BatchActionFunc
+}
+
+// Batch returns a BatchBuilder for accumulating actions to submit together.
+// Batch submission requires an ExchangeOptNonceManager, since reserving a
+// contiguous window of nonces up-front is what lets the batch's actions be
+// signed and posted concurrently instead of one round trip at a time.
+func (e *Exchange) Batch() *BatchBuilder {
+	return &BatchBuilder{exchange: e}
+}
+
+// Add appends an action to the batch. build is called with the nonce
+// reserved for this action once Submit runs.
+func (b *BatchBuilder) Add(build BatchActionFunc) *BatchBuilder {
+	b.builders = append(b.builders, build)
+	return b
+}
+
+// BatchItemResult is the outcome of one action submitted as part of a
+// batch, indexed the same way it was Add-ed so callers can retry only the
+// failures, reusing the same reserved Nonce.
+type BatchItemResult struct {
+	Index    int
+	Nonce    int64
+	Response json.RawMessage
+	Err      error
+}
+
+// BatchResult is the overall outcome of a submitted batch.
+type BatchResult struct {
+	Items []BatchItemResult
+}
+
+// Failed returns the items that did not complete successfully, in the
+// original batch order.
+func (r *BatchResult) Failed() []BatchItemResult {
+	var failed []BatchItemResult
+	for _, item := range r.Items {
+		if item.Err != nil {
+			failed = append(failed, item)
+		}
+	}
+	return failed
+}
+
+// Submit reserves one nonce per accumulated action, in strictly
+// increasing order, then signs and posts them concurrently with bounded
+// parallelism. Each item's context is cancelled independently of the
+// others: one slow or failing action does not block or abort its
+// siblings, so callers can retry only BatchResult.Failed() using the
+// nonces already attached to each BatchItemResult.
+func (b *BatchBuilder) Submit(ctx context.Context) (*BatchResult, error) {
+	n := len(b.builders)
+	if n == 0 {
+		return &BatchResult{}, nil
+	}
+
+	e := b.exchange
+	if e.nonceManager == nil {
+		return nil, fmt.Errorf(
+			"batch submission requires ExchangeOptNonceManager: " +
+				"a contiguous nonce window cannot be reserved without it",
+		)
+	}
+
+	reservation, err := e.nonceManager.Reserve(e.signerOrDefault().Address().Hex(), n)
+	if err != nil {
+		return nil, fmt.Errorf("reserve batch nonces: %w", err)
+	}
+	nonces := reservation.Nonces()
+
+	items := make([]BatchItemResult, n)
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, maxBatchParallelism)
+
+	for i, build := range b.builders {
+		items[i] = BatchItemResult{Index: i, Nonce: nonces[i]}
+
+		wg.Add(1)
+		go func(i int, build BatchActionFunc, nonce int64) {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			itemCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			items[i].Response, items[i].Err = e.submitBatchAction(itemCtx, build(nonce), nonce)
+		}(i, build, nonces[i])
+	}
+	wg.Wait()
+
+	succeeded := 0
+	for _, item := range items {
+		if item.Err == nil {
+			succeeded++
+		}
+	}
+	if succeeded == 0 {
+		// Nothing landed, so it is safe to hand these nonces out again.
+		_ = reservation.Release()
+	} else {
+		reservation.Commit()
+	}
+
+	return &BatchResult{Items: items}, nil
+}
+
+// submitBatchAction signs and posts a single batch action under nonce.
+func (e *Exchange) submitBatchAction(ctx context.Context, action any, nonce int64) (json.RawMessage, error) {
+	sig, err := SignL1ActionWithSigner(
+		ctx,
+		e.signerOrDefault(),
+		action,
+		e.vault,
+		nonce,
+		e.expiresAfter,
+		e.client.baseURL == MainnetAPIURL,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("sign batch action: %w", err)
+	}
+
+	resp, err := e.postAction(ctx, action, sig, nonce)
+	if err != nil {
+		return nil, fmt.Errorf("post batch action: %w", err)
+	}
+	return resp, nil
+}
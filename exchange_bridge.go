@@ -0,0 +1,36 @@
+package hyperliquid
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// WithdrawToChain withdraws amount USDC from Hyperliquid and forwards it
+// to destAddress on destChainID through the downstream bridge selected by
+// opts.Provider, using the BridgeRouter configured via
+// ExchangeOptBridgeRouter. See BridgeRouter.WithdrawToChain for the
+// underlying multi-stage flow.
+func (e *Exchange) WithdrawToChain(
+	ctx context.Context,
+	amount float64,
+	destChainID uint64,
+	destAddress common.Address,
+	opts BridgeOpts,
+) (*WithdrawalTicket, error) {
+	if e.bridgeRouter == nil {
+		return nil, fmt.Errorf("WithdrawToChain requires ExchangeOptBridgeRouter to be configured")
+	}
+	return e.bridgeRouter.WithdrawToChain(ctx, amount, destChainID, destAddress, opts)
+}
+
+// ResumeWithdrawal continues a WithdrawalTicket created by WithdrawToChain
+// from whatever stage it was last persisted at. See
+// BridgeRouter.ResumeWithdrawal.
+func (e *Exchange) ResumeWithdrawal(ctx context.Context, ticketID string) (*WithdrawalTicket, error) {
+	if e.bridgeRouter == nil {
+		return nil, fmt.Errorf("ResumeWithdrawal requires ExchangeOptBridgeRouter to be configured")
+	}
+	return e.bridgeRouter.ResumeWithdrawal(ctx, ticketID)
+}
@@ -0,0 +1,32 @@
+package hyperliquid
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ciCloidPrefixLen is how many leading bytes of a CI-tagged cloid are the
+// deterministic commit fingerprint; the rest are random so orders from the
+// same CI run still get distinct cloids.
+const ciCloidPrefixLen = 4
+
+// ciClientOrderID derives a cloid for an order placed under
+// ExchangeOptCIMetadata: its first ciCloidPrefixLen bytes are
+// keccak256(commitSHA)[:ciCloidPrefixLen], so every order from the same CI
+// build shares a recognizable on-chain prefix, and the remaining bytes are
+// random so orders within that build still get distinct cloids. Unlike
+// deterministicCloid, this is intentionally non-reproducible across calls:
+// it isn't used for idempotency, only for auditability.
+func ciClientOrderID(commitSHA string) (string, error) {
+	digest := crypto.Keccak256([]byte(commitSHA))
+
+	buf := make([]byte, 16)
+	copy(buf, digest[:ciCloidPrefixLen])
+	if _, err := rand.Read(buf[ciCloidPrefixLen:]); err != nil {
+		return "", err
+	}
+
+	return "0x" + hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,71 @@
+package hyperliquid
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// SignedAction is the fully-signed action envelope built right before
+// postAction would otherwise POST it: the action payload itself, the nonce
+// and signature produced over it, and the chain/vault/expiry parameters
+// that shaped the signature. ExchangeOptDryRun and ExchangeOptOfflineSigner
+// hand this to the caller instead of submitting it; SubmitSigned accepts it
+// back to post it later, making the sign-vs-submit boundary a first-class
+// API rather than something buried inside every action method.
+type SignedAction struct {
+	Action       any             `json:"action"`
+	Nonce        int64           `json:"nonce"`
+	Signature    SignatureResult `json:"signature"`
+	VaultAddress string          `json:"vaultAddress,omitempty"`
+	ExpiresAfter *int64          `json:"expiresAfter,omitempty"`
+}
+
+// dryRunAction builds the SignedAction envelope for action, hands it to
+// e.dryRunSink if one is configured, and returns the envelope's own JSON
+// encoding in place of a network response. Dry-run callers get back the
+// envelope shape rather than a real API response; that is the point of
+// dry-run mode, not an incidental limitation.
+func (e *Exchange) dryRunAction(
+	action any,
+	signature SignatureResult,
+	nonce int64,
+	payload map[string]any,
+) ([]byte, error) {
+	envelope := SignedAction{
+		Action:    action,
+		Nonce:     nonce,
+		Signature: signature,
+	}
+	if v, ok := payload["vaultAddress"].(string); ok {
+		envelope.VaultAddress = v
+	}
+	if e.expiresAfter != nil {
+		envelope.ExpiresAfter = e.expiresAfter
+	}
+
+	if e.dryRunSink != nil {
+		e.dryRunSink(envelope)
+	}
+
+	return json.Marshal(envelope)
+}
+
+// SubmitSigned posts a SignedAction produced earlier — by ExchangeOptDryRun,
+// ExchangeOptOfflineSigner, or an air-gapped signer elsewhere — rather than
+// signing a new one. envelope's nonce and signature are used as-is; e's own
+// nonce allocation and signer are not consulted.
+func (e *Exchange) SubmitSigned(ctx context.Context, envelope SignedAction) (json.RawMessage, error) {
+	payload := map[string]any{
+		"action":    envelope.Action,
+		"nonce":     envelope.Nonce,
+		"signature": envelope.Signature,
+	}
+	if envelope.VaultAddress != "" {
+		payload["vaultAddress"] = envelope.VaultAddress
+	}
+	if envelope.ExpiresAfter != nil {
+		payload["expiresAfter"] = *envelope.ExpiresAfter
+	}
+
+	return e.client.post(ctx, "/exchange", payload)
+}
@@ -0,0 +1,91 @@
+package hyperliquid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// MultiSigSignature is a signature collected from one authorized co-signer
+// over a multi-sig action's digest, as produced by SignMultiSigPayload.
+type MultiSigSignature struct {
+	Signer    string
+	Signature SignatureResult
+}
+
+// SignMultiSigPayload signs the phantom-agent digest of innerAction on
+// behalf of multiSigUser using e's configured signer, for the given outer
+// nonce. A coordinator process calls this once per authorized signer
+// (potentially against Exchange instances backed by different remote
+// signers, all agreeing on the same innerAction/multiSigUser/nonce ahead of
+// time) and gathers the results before calling SubmitMultiSig.
+func (e *Exchange) SignMultiSigPayload(
+	ctx context.Context,
+	innerAction map[string]any,
+	multiSigUser string,
+	nonce int64,
+) (MultiSigSignature, error) {
+	isMainnet := e.client.baseURL == MainnetAPIURL
+	coordinator := NewMultiSigCoordinator(innerAction, multiSigUser, nonce, 1, isMainnet)
+
+	phantomAgent := constructPhantomAgent(coordinator.Digest(), isMainnet)
+	typedData := l1Payload(phantomAgent, isMainnet)
+
+	signer := e.signerOrDefault()
+	sig, err := signInnerWithSigner(ctx, signer, typedData)
+	if err != nil {
+		return MultiSigSignature{}, fmt.Errorf("sign multi-sig payload: %w", err)
+	}
+
+	return MultiSigSignature{Signer: signer.Address().Hex(), Signature: sig}, nil
+}
+
+// SubmitMultiSig wraps innerAction in the multiSig envelope on behalf of
+// multiSigUser, verifies that signatures meets the threshold recorded
+// on-chain for multiSigUser, signs the outer envelope with e's own signer,
+// and posts the assembled action. signatures must all have been produced
+// over the same (innerAction, multiSigUser, nonce) via SignMultiSigPayload.
+func (e *Exchange) SubmitMultiSig(
+	ctx context.Context,
+	innerAction map[string]any,
+	multiSigUser string,
+	nonce int64,
+	signatures []MultiSigSignature,
+) (json.RawMessage, error) {
+	signerInfo, err := e.info.MultiSigSignersWithContext(ctx, multiSigUser)
+	if err != nil {
+		return nil, fmt.Errorf("fetch multi-sig signers: %w", err)
+	}
+
+	isMainnet := e.client.baseURL == MainnetAPIURL
+	coordinator := NewMultiSigCoordinator(innerAction, multiSigUser, nonce, signerInfo.Threshold, isMainnet).
+		WithAuthorizedSigners(signerInfo.AuthorizedUsers)
+
+	for _, sig := range signatures {
+		if err := coordinator.AddSignature(sig.Signer, sig.Signature); err != nil {
+			return nil, fmt.Errorf("add signature from %s: %w", sig.Signer, err)
+		}
+	}
+
+	if !coordinator.Ready() {
+		return nil, fmt.Errorf(
+			"multi-sig: have %d of %d required signatures",
+			len(signatures),
+			signerInfo.Threshold,
+		)
+	}
+
+	action, outerSig, err := coordinator.Finalize(func(action any) (SignatureResult, error) {
+		return SignL1ActionWithSigner(ctx, e.signerOrDefault(), action, "", nonce, e.expiresAfter, isMainnet)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("finalize multi-sig action: %w", err)
+	}
+
+	resp, err := e.postAction(ctx, action, outerSig, nonce)
+	if err != nil {
+		return nil, fmt.Errorf("post multi-sig action: %w", err)
+	}
+
+	return resp, nil
+}
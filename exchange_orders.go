@@ -14,6 +14,14 @@ type CreateOrderRequest struct {
 	ReduceOnly    bool
 	OrderType     OrderType
 	ClientOrderID *string
+
+	// IdempotencyKey, when set and ClientOrderID is nil, makes
+	// newCreateOrderAction derive a deterministic ClientOrderID from
+	// (account address, IdempotencyKey) via deterministicCloid instead of
+	// submitting without a cloid. Pair it with Exchange.OrderIdempotent,
+	// which uses the same derived cloid to recognize an order that landed
+	// despite a network error, rather than risking a duplicate retry.
+	IdempotencyKey string
 }
 
 func (s *CreateOrderRequest) String() string {
@@ -80,15 +88,37 @@ func newCreateOrderAction(
 	e *Exchange,
 	orders []CreateOrderRequest,
 	info *BuilderInfo,
+) (OrderAction, error) {
+	return newGroupedCreateOrderAction(e, orders, info, string(GroupingNA))
+}
+
+// newGroupedCreateOrderAction is newCreateOrderAction with an explicit
+// Grouping, so PlaceBracket can submit its entry/TP/SL legs under
+// GroupingNormalTpsl or GroupingPositionTpsl in one OrderAction instead of
+// the GroupingNA every other caller uses.
+func newGroupedCreateOrderAction(
+	e *Exchange,
+	orders []CreateOrderRequest,
+	info *BuilderInfo,
+	grouping string,
 ) (OrderAction, error) {
 	orderRequests := make([]OrderWire, len(orders))
 	for i, order := range orders {
-		priceWire, err := floatToWire(order.Price)
+		roundedPrice, err := e.RoundPrice(order.Coin, order.Price, order.IsBuy)
+		if err != nil {
+			return OrderAction{}, fmt.Errorf("failed to round price for order %d: %w", i, err)
+		}
+		roundedSize, err := e.RoundSize(order.Coin, order.Size)
+		if err != nil {
+			return OrderAction{}, fmt.Errorf("failed to round size for order %d: %w", i, err)
+		}
+
+		priceWire, err := floatToWire(roundedPrice)
 		if err != nil {
 			return OrderAction{}, fmt.Errorf("failed to wire price for order %d: %w", i, err)
 		}
 
-		sizeWire, err := floatToWire(order.Size)
+		sizeWire, err := floatToWire(roundedSize)
 		if err != nil {
 			return OrderAction{}, fmt.Errorf("failed to wire size for order %d: %w", i, err)
 		}
@@ -107,8 +137,21 @@ func newCreateOrderAction(
 			OrderType:  newOrderTypeWire(order),
 		}
 
+		clientOrderID := order.ClientOrderID
+		if clientOrderID == nil && order.IdempotencyKey != "" {
+			cloid := deterministicCloid(e.idempotencyAddress(), order.IdempotencyKey)
+			clientOrderID = &cloid
+		}
+		if clientOrderID == nil && e.ciInfo.CommitSHA != "" {
+			cloid, err := ciClientOrderID(e.ciInfo.CommitSHA)
+			if err != nil {
+				return OrderAction{}, fmt.Errorf("failed to derive CI cloid for order %d: %w", i, err)
+			}
+			clientOrderID = &cloid
+		}
+
 		// Normalize cloid to match Python SDK format (hex WITH 0x prefix)
-		normalizedCloid, err := normalizeCloid(order.ClientOrderID)
+		normalizedCloid, err := normalizeCloid(clientOrderID)
 		if err != nil {
 			return OrderAction{}, fmt.Errorf("invalid cloid for order %d: %w", i, err)
 		}
@@ -120,7 +163,7 @@ func newCreateOrderAction(
 	res := OrderAction{
 		Type:     "order",
 		Orders:   orderRequests,
-		Grouping: string(GroupingNA),
+		Grouping: grouping,
 		Builder:  info,
 	}
 
@@ -138,13 +181,21 @@ func (e *Exchange) Order(
 	}
 
 	if !resp.Ok {
-		err = fmt.Errorf("failed to create order: %s", resp.Err)
+		if resp.Err != "" {
+			err = classifyOrderError(resp.Err)
+		} else {
+			err = fmt.Errorf("failed to create order")
+		}
 		return
 	}
 
 	data := resp.Data
 	if len(data.Statuses) == 0 {
-		err = fmt.Errorf("no status for order: %s", resp.Err)
+		if resp.Err != "" {
+			err = classifyOrderError(resp.Err)
+		} else {
+			err = fmt.Errorf("no status for order")
+		}
 		return
 	}
 
@@ -169,7 +220,7 @@ func (e *Exchange) BulkOrders(
 		// check if any of the statuses has an error set
 		for _, s := range result.Data.Statuses {
 			if s.Error != nil {
-				return result, fmt.Errorf("%s", *s.Error)
+				return result, classifyOrderError(*s.Error)
 			}
 		}
 	}
@@ -206,12 +257,21 @@ func newModifyOrderAction(
 		return ModifyAction{}, fmt.Errorf("modify request must specify either Oid or Cloid")
 	}
 
-	priceWire, err := floatToWire(modifyRequest.Order.Price)
+	roundedPrice, err := e.RoundPrice(modifyRequest.Order.Coin, modifyRequest.Order.Price, modifyRequest.Order.IsBuy)
+	if err != nil {
+		return ModifyAction{}, fmt.Errorf("failed to round price: %w", err)
+	}
+	roundedSize, err := e.RoundSize(modifyRequest.Order.Coin, modifyRequest.Order.Size)
+	if err != nil {
+		return ModifyAction{}, fmt.Errorf("failed to round size: %w", err)
+	}
+
+	priceWire, err := floatToWire(roundedPrice)
 	if err != nil {
 		return ModifyAction{}, fmt.Errorf("failed to wire price: %w", err)
 	}
 
-	sizeWire, err := floatToWire(modifyRequest.Order.Size)
+	sizeWire, err := floatToWire(roundedSize)
 	if err != nil {
 		return ModifyAction{}, fmt.Errorf("failed to wire size: %w", err)
 	}
@@ -296,13 +356,21 @@ func (e *Exchange) ModifyOrder(
 	}
 
 	if !resp.Ok {
-		err = fmt.Errorf("failed to modify order: %s", resp.Err)
+		if resp.Err != "" {
+			err = classifyOrderError(resp.Err)
+		} else {
+			err = fmt.Errorf("failed to modify order")
+		}
 		return
 	}
 
 	data := resp.Data
 	if len(data.Statuses) == 0 {
-		err = fmt.Errorf("no status for modified order: %s", resp.Err)
+		if resp.Err != "" {
+			err = classifyOrderError(resp.Err)
+		} else {
+			err = fmt.Errorf("no status for modified order")
+		}
 		return
 	}
 
@@ -326,12 +394,18 @@ func (e *Exchange) BulkModifyOrders(
 	}
 
 	if !resp.Ok {
-		return nil, fmt.Errorf("failed to modify orders: %s", resp.Err)
+		if resp.Err != "" {
+			return nil, classifyOrderError(resp.Err)
+		}
+		return nil, fmt.Errorf("failed to modify orders")
 	}
 
 	data := resp.Data
 	if len(data.Statuses) == 0 {
-		return nil, fmt.Errorf("no status for modified order: %s", resp.Err)
+		if resp.Err != "" {
+			return nil, classifyOrderError(resp.Err)
+		}
+		return nil, fmt.Errorf("no status for modified orders")
 	}
 
 	return data.Statuses, nil
@@ -0,0 +1,168 @@
+package hyperliquid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// BatchOrderResult pairs a CreateOrderRequest submitted via BatchPlaceOrders
+// with its outcome: exactly one of Status or Err is set.
+type BatchOrderResult struct {
+	Request CreateOrderRequest
+	Status  OrderStatus
+	Err     error
+}
+
+// BatchPlaceOrders submits every order in one request, the same as
+// BulkOrders, but unlike BulkOrders it never aborts on the first rejected
+// leg: every order gets a BatchOrderResult pairing it with its own
+// OrderStatus or classified error (see classifyOrderError), so partial
+// fills/rejects in a grid or market-making batch don't hide the legs that
+// succeeded. Use BatchRetryPlaceOrders to resubmit just the failed,
+// retryable legs.
+func (e *Exchange) BatchPlaceOrders(
+	ctx context.Context,
+	orders []CreateOrderRequest,
+	builder *BuilderInfo,
+) ([]BatchOrderResult, error) {
+	action, err := newCreateOrderAction(e, orders, builder)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create batch order action: %w", err)
+	}
+
+	var resp APIResponse[OrderResponse]
+	if err := e.executeAction(ctx, action, &resp); err != nil {
+		return nil, fmt.Errorf("failed to submit batch orders: %w", err)
+	}
+
+	if !resp.Ok {
+		if resp.Err != "" {
+			return nil, classifyOrderError(resp.Err)
+		}
+		return nil, fmt.Errorf("batch place orders failed")
+	}
+
+	results := make([]BatchOrderResult, len(orders))
+	for i, order := range orders {
+		results[i].Request = order
+
+		if i >= len(resp.Data.Statuses) {
+			results[i].Err = fmt.Errorf("no status returned for order %d", i)
+			continue
+		}
+
+		status := resp.Data.Statuses[i]
+		if status.Error != nil {
+			results[i].Err = classifyOrderError(*status.Error)
+			continue
+		}
+		results[i].Status = status
+	}
+
+	return results, nil
+}
+
+// RetryPolicy configures BatchRetryPlaceOrders.
+type RetryPolicy struct {
+	// MaxAttempts is the number of extra submission rounds to try,
+	// beyond the original BatchPlaceOrders call. Zero disables retrying.
+	MaxAttempts int
+	// BaseDelay is the first backoff delay between retry rounds; it
+	// doubles each round up to MaxDelay, the same jittered-exponential
+	// shape as WebsocketClient's reconnect backoff.
+	BaseDelay time.Duration
+	// MaxDelay caps the jittered backoff delay.
+	MaxDelay time.Duration
+	// IsRetryable classifies an order's error as transient and worth
+	// resubmitting. Defaults to DefaultIsRetryable when nil.
+	IsRetryable func(err error) bool
+	// OnAttempt, if set, is called in each retry round once for every
+	// entry that round resubmitted, with the 1-indexed attempt number and
+	// that entry's new result, for logging.
+	OnAttempt func(attempt int, result BatchOrderResult)
+}
+
+// DefaultIsRetryable treats rate-limit and insufficient-margin rejections
+// as transient: both can clear on their own (a rate-limit window rolling
+// over, margin freed by another fill) without the caller changing
+// anything about the order itself.
+func DefaultIsRetryable(err error) bool {
+	return errors.Is(err, ErrRateLimited) || errors.Is(err, ErrInsufficientMargin)
+}
+
+func (p RetryPolicy) isRetryable() func(error) bool {
+	if p.IsRetryable != nil {
+		return p.IsRetryable
+	}
+	return DefaultIsRetryable
+}
+
+// BatchRetryPlaceOrders resubmits only the entries in results whose Err is
+// classified as retryable by policy.IsRetryable, up to policy.MaxAttempts
+// rounds with jittered exponential backoff between rounds, and returns the
+// full results slice (same length and order as the input) with each
+// successfully-retried entry updated in place.
+func (e *Exchange) BatchRetryPlaceOrders(
+	ctx context.Context,
+	results []BatchOrderResult,
+	builder *BuilderInfo,
+	policy RetryPolicy,
+) ([]BatchOrderResult, error) {
+	isRetryable := policy.isRetryable()
+
+	for attempt := 1; attempt <= policy.MaxAttempts; attempt++ {
+		var (
+			pendingIdx []int
+			pending    []CreateOrderRequest
+		)
+		for i, r := range results {
+			if r.Err != nil && isRetryable(r.Err) {
+				pendingIdx = append(pendingIdx, i)
+				pending = append(pending, r.Request)
+			}
+		}
+
+		if len(pending) == 0 {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return results, ctx.Err()
+		case <-time.After(retryBackoffDelay(policy, attempt)):
+		}
+
+		retried, err := e.BatchPlaceOrders(ctx, pending, builder)
+		if err != nil {
+			return results, fmt.Errorf("batch retry attempt %d: %w", attempt, err)
+		}
+
+		for j, idx := range pendingIdx {
+			results[idx] = retried[j]
+			if policy.OnAttempt != nil {
+				policy.OnAttempt(attempt, results[idx])
+			}
+		}
+	}
+
+	return results, nil
+}
+
+// retryBackoffDelay returns a full-jitter exponential backoff delay for the
+// given 1-indexed attempt, capped at policy.MaxDelay.
+func retryBackoffDelay(policy RetryPolicy, attempt int) time.Duration {
+	base := policy.BaseDelay
+	if base <= 0 {
+		return 0
+	}
+
+	backoff := base << uint(attempt-1) //nolint:gosec // attempt is bounded by MaxAttempts
+	if policy.MaxDelay > 0 && (backoff <= 0 || backoff > policy.MaxDelay) {
+		backoff = policy.MaxDelay
+	}
+
+	return time.Duration(rand.Int63n(int64(backoff) + 1))
+}
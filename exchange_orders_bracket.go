@@ -0,0 +1,160 @@
+package hyperliquid
+
+import (
+	"context"
+	"fmt"
+)
+
+// TriggerLeg describes one take-profit or stop-loss leg of a BracketRequest.
+// TriggerPx is the price that arms the exit order. When IsMarket is false,
+// LimitOffset is added to (for a sell exit) or subtracted from (for a buy
+// exit) TriggerPx to get the exit order's limit price, the same way a
+// trader places a limit a few ticks past the trigger to guarantee fill
+// priority without fully market-ordering out of the position.
+type TriggerLeg struct {
+	TriggerPx   float64
+	IsMarket    bool
+	LimitOffset float64
+}
+
+// BracketRequest submits an entry order together with its take-profit
+// and/or stop-loss exit orders via Exchange.PlaceBracket. At least one of
+// TakeProfit or StopLoss must be set.
+type BracketRequest struct {
+	Entry      CreateOrderRequest
+	TakeProfit *TriggerLeg
+	StopLoss   *TriggerLeg
+}
+
+// BracketResult is the outcome of one Exchange.PlaceBracket call, with each
+// leg's OrderStatus nil when that leg wasn't requested.
+type BracketResult struct {
+	Entry      OrderStatus
+	TakeProfit *OrderStatus
+	StopLoss   *OrderStatus
+}
+
+// exitOrder builds the ReduceOnly trigger order for one TriggerLeg of
+// entry, flipping IsBuy to the opposite side of entry the way closing a
+// position always does.
+func exitOrder(entry CreateOrderRequest, leg TriggerLeg, tpsl string) CreateOrderRequest {
+	isBuy := !entry.IsBuy
+
+	price := leg.TriggerPx
+	if !leg.IsMarket {
+		if isBuy {
+			price -= leg.LimitOffset
+		} else {
+			price += leg.LimitOffset
+		}
+	}
+
+	return CreateOrderRequest{
+		Coin:       entry.Coin,
+		IsBuy:      isBuy,
+		Price:      price,
+		Size:       entry.Size,
+		ReduceOnly: true,
+		OrderType: OrderType{
+			Trigger: &TriggerOrderType{
+				TriggerPx: leg.TriggerPx,
+				IsMarket:  leg.IsMarket,
+				Tpsl:      tpsl,
+			},
+		},
+	}
+}
+
+// validateBracket checks TakeProfit/StopLoss trigger prices bracket
+// Entry.Price in the direction that makes them a real take-profit/stop-loss
+// for Entry.IsBuy: for a long, TakeProfit > Entry.Price > StopLoss; for a
+// short, the inequalities flip.
+func validateBracket(req BracketRequest) error {
+	if req.TakeProfit == nil && req.StopLoss == nil {
+		return fmt.Errorf("bracket request needs at least one of TakeProfit or StopLoss")
+	}
+
+	entryPx := req.Entry.Price
+	if req.Entry.IsBuy {
+		if req.TakeProfit != nil && req.TakeProfit.TriggerPx <= entryPx {
+			return fmt.Errorf("take-profit price %.8f must be above entry price %.8f for a long", req.TakeProfit.TriggerPx, entryPx)
+		}
+		if req.StopLoss != nil && req.StopLoss.TriggerPx >= entryPx {
+			return fmt.Errorf("stop-loss price %.8f must be below entry price %.8f for a long", req.StopLoss.TriggerPx, entryPx)
+		}
+	} else {
+		if req.TakeProfit != nil && req.TakeProfit.TriggerPx >= entryPx {
+			return fmt.Errorf("take-profit price %.8f must be below entry price %.8f for a short", req.TakeProfit.TriggerPx, entryPx)
+		}
+		if req.StopLoss != nil && req.StopLoss.TriggerPx <= entryPx {
+			return fmt.Errorf("stop-loss price %.8f must be above entry price %.8f for a short", req.StopLoss.TriggerPx, entryPx)
+		}
+	}
+
+	return nil
+}
+
+// PlaceBracket submits req.Entry together with its take-profit and/or
+// stop-loss exit orders as a single BulkOrders-equivalent call, grouped
+// under GroupingNormalTpsl so Hyperliquid links the exits to the entry
+// atomically instead of the three independent, non-atomic calls a caller
+// would otherwise need (Order for the entry, then two more for the exits,
+// with no guarantee all three land or none do).
+func (e *Exchange) PlaceBracket(
+	ctx context.Context,
+	req BracketRequest,
+	builder *BuilderInfo,
+) (BracketResult, error) {
+	if err := validateBracket(req); err != nil {
+		return BracketResult{}, err
+	}
+
+	orders := []CreateOrderRequest{req.Entry}
+
+	tpIndex, slIndex := -1, -1
+	if req.TakeProfit != nil {
+		tpIndex = len(orders)
+		orders = append(orders, exitOrder(req.Entry, *req.TakeProfit, "tp"))
+	}
+	if req.StopLoss != nil {
+		slIndex = len(orders)
+		orders = append(orders, exitOrder(req.Entry, *req.StopLoss, "sl"))
+	}
+
+	action, err := newGroupedCreateOrderAction(e, orders, builder, string(GroupingNormalTpsl))
+	if err != nil {
+		return BracketResult{}, err
+	}
+
+	var resp APIResponse[OrderResponse]
+	if err := e.executeAction(ctx, action, &resp); err != nil {
+		return BracketResult{}, err
+	}
+
+	if !resp.Ok {
+		if resp.Err != "" {
+			return BracketResult{}, classifyOrderError(resp.Err)
+		}
+		return BracketResult{}, fmt.Errorf("failed to place bracket order")
+	}
+
+	statuses := resp.Data.Statuses
+	if len(statuses) != len(orders) {
+		return BracketResult{}, fmt.Errorf("expected %d order statuses, got %d", len(orders), len(statuses))
+	}
+	for _, s := range statuses {
+		if s.Error != nil {
+			return BracketResult{}, classifyOrderError(*s.Error)
+		}
+	}
+
+	result := BracketResult{Entry: statuses[0]}
+	if tpIndex >= 0 {
+		result.TakeProfit = &statuses[tpIndex]
+	}
+	if slIndex >= 0 {
+		result.StopLoss = &statuses[slIndex]
+	}
+
+	return result, nil
+}
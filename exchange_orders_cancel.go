@@ -56,7 +56,7 @@ func (e *Exchange) BulkCancel(
 
 	if res == nil || !res.Ok || res.Status == "err" {
 		if res != nil && res.Err != "" {
-			return res, fmt.Errorf("%s", res.Err)
+			return res, classifyOrderError(res.Err)
 		}
 		return res, fmt.Errorf("cancel failed")
 	}
@@ -120,7 +120,7 @@ func (e *Exchange) BulkCancelByCloids(
 
 	if res == nil || !res.Ok || res.Status == "err" {
 		if res != nil && res.Err != "" {
-			return res, fmt.Errorf("%s", res.Err)
+			return res, classifyOrderError(res.Err)
 		}
 		return res, fmt.Errorf("cancel failed")
 	}
@@ -0,0 +1,73 @@
+package hyperliquid
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// idempotencyAddress is the account address deterministicCloid and
+// OrderIdempotent key off of: e.accountAddr, falling back to e.vault the
+// same way RefreshPositionsCache resolves the address to query.
+func (e *Exchange) idempotencyAddress() string {
+	if e.accountAddr != "" {
+		return e.accountAddr
+	}
+	return e.vault
+}
+
+// deterministicCloid derives a 16-byte client order ID from address and key
+// via keccak256(address || key)[:16], so the same (address, key) pair
+// always produces the same cloid. CreateOrderRequest.IdempotencyKey and
+// Exchange.OrderIdempotent both key off this function, so a retried
+// submission with the same key always carries the same cloid.
+func deterministicCloid(address, key string) string {
+	digest := crypto.Keccak256([]byte(address + key))
+	return "0x" + hex.EncodeToString(digest[:16])
+}
+
+// OrderIdempotent submits req the same way Order does, but when
+// req.IdempotencyKey is set, it first derives req.ClientOrderID (if not
+// already set) from the key via deterministicCloid, and, if the submission
+// itself fails, queries the order by that cloid via Info.QueryOrderByCloid
+// before giving up. This covers the case BulkOrders otherwise can't: the
+// HTTP POST in Client.post times out or errors after Hyperliquid already
+// accepted the order, so a naive retry under a fresh request would double
+// the fill. A request with no IdempotencyKey behaves exactly like Order.
+func (e *Exchange) OrderIdempotent(
+	ctx context.Context,
+	req CreateOrderRequest,
+	builder *BuilderInfo,
+) (OrderStatus, error) {
+	if req.IdempotencyKey == "" {
+		return e.Order(ctx, req, builder)
+	}
+
+	address := e.idempotencyAddress()
+	if req.ClientOrderID == nil {
+		cloid := deterministicCloid(address, req.IdempotencyKey)
+		req.ClientOrderID = &cloid
+	}
+
+	status, err := e.Order(ctx, req, builder)
+	if err == nil {
+		return status, nil
+	}
+
+	// The submission itself failed or was ambiguous (network error,
+	// timeout, context deadline). Before surfacing err, check whether the
+	// order landed anyway under the deterministic cloid.
+	queried, queryErr := e.info.QueryOrderByCloidWithContext(ctx, address, *req.ClientOrderID)
+	if queryErr != nil || queried == nil || queried.Status != OrderQueryStatusSuccess {
+		return status, err
+	}
+
+	return OrderStatus{
+		Resting: &OrderStatusResting{
+			Oid:      queried.Order.Order.Oid,
+			ClientID: req.ClientOrderID,
+			Status:   string(queried.Order.Status),
+		},
+	}, nil
+}
@@ -0,0 +1,185 @@
+package hyperliquid
+
+import "context"
+
+// defaultMarketSlippage is the slippage MarketBuy/MarketSell apply when the
+// caller doesn't pass WithSlippage, matching the Python SDK's default.
+const defaultMarketSlippage = 0.05
+
+// limitOrderParams accumulates LimitOrderOpts for LimitBuy/LimitSell.
+type limitOrderParams struct {
+	tif        string
+	reduceOnly bool
+	cloid      *string
+	builder    *BuilderInfo
+}
+
+// LimitOrderOpt configures a limit order placed by LimitBuy/LimitSell,
+// following this module's functional-options convention so callers don't
+// have to build the nested OrderType{Limit: &LimitOrderType{...}} struct by
+// hand.
+type LimitOrderOpt func(*limitOrderParams)
+
+// WithTif sets the order's time-in-force (TifGtc, TifIoc, or TifAlo).
+// LimitBuy/LimitSell default to TifGtc when not given.
+func WithTif(tif string) LimitOrderOpt {
+	return func(p *limitOrderParams) {
+		p.tif = tif
+	}
+}
+
+// WithPostOnly is WithTif(TifAlo): Hyperliquid's add-liquidity-only
+// time-in-force, which the server rejects instead of filling if it would
+// have crossed the book (see ErrPostOnlyWouldCross).
+func WithPostOnly() LimitOrderOpt {
+	return WithTif(TifAlo)
+}
+
+// WithReduceOnly marks the order as reduce-only.
+func WithReduceOnly() LimitOrderOpt {
+	return func(p *limitOrderParams) {
+		p.reduceOnly = true
+	}
+}
+
+// WithCloid attaches a client order ID.
+func WithCloid(cloid string) LimitOrderOpt {
+	return func(p *limitOrderParams) {
+		p.cloid = &cloid
+	}
+}
+
+// WithBuilder routes the order's builder fee to info, the same as passing
+// builder directly to Order/BulkOrders.
+func WithBuilder(info *BuilderInfo) LimitOrderOpt {
+	return func(p *limitOrderParams) {
+		p.builder = info
+	}
+}
+
+// LimitBuy places a buy limit order for sz at px, configured by opts.
+// Defaults to TifGtc, not reduce-only, no cloid, no builder fee.
+func (e *Exchange) LimitBuy(
+	ctx context.Context,
+	coin string,
+	px, sz float64,
+	opts ...LimitOrderOpt,
+) (OrderStatus, error) {
+	return e.limitOrder(ctx, coin, true, px, sz, opts...)
+}
+
+// LimitSell places a sell limit order for sz at px, configured by opts.
+// Defaults to TifGtc, not reduce-only, no cloid, no builder fee.
+func (e *Exchange) LimitSell(
+	ctx context.Context,
+	coin string,
+	px, sz float64,
+	opts ...LimitOrderOpt,
+) (OrderStatus, error) {
+	return e.limitOrder(ctx, coin, false, px, sz, opts...)
+}
+
+func (e *Exchange) limitOrder(
+	ctx context.Context,
+	coin string,
+	isBuy bool,
+	px, sz float64,
+	opts ...LimitOrderOpt,
+) (OrderStatus, error) {
+	p := limitOrderParams{tif: TifGtc}
+	for _, opt := range opts {
+		opt(&p)
+	}
+
+	req := CreateOrderRequest{
+		Coin:          coin,
+		IsBuy:         isBuy,
+		Price:         px,
+		Size:          sz,
+		ReduceOnly:    p.reduceOnly,
+		OrderType:     OrderType{Limit: &LimitOrderType{Tif: p.tif}},
+		ClientOrderID: p.cloid,
+	}
+
+	return e.Order(ctx, req, p.builder)
+}
+
+// marketOrderParams accumulates MarketOrderOpts for MarketBuy/MarketSell.
+type marketOrderParams struct {
+	slippage float64
+	px       *float64
+	cloid    *string
+	builder  *BuilderInfo
+}
+
+// MarketOrderOpt configures a market order placed by MarketBuy/MarketSell.
+type MarketOrderOpt func(*marketOrderParams)
+
+// WithSlippage overrides the slippage MarketBuy/MarketSell tolerate around
+// the current price, which defaults to defaultMarketSlippage.
+func WithSlippage(slippage float64) MarketOrderOpt {
+	return func(p *marketOrderParams) {
+		p.slippage = slippage
+	}
+}
+
+// WithMarketPx overrides the reference price MarketBuy/MarketSell apply
+// slippage around, instead of fetching the current mid/mark price.
+func WithMarketPx(px float64) MarketOrderOpt {
+	return func(p *marketOrderParams) {
+		p.px = &px
+	}
+}
+
+// WithMarketCloid attaches a client order ID to a market order.
+func WithMarketCloid(cloid string) MarketOrderOpt {
+	return func(p *marketOrderParams) {
+		p.cloid = &cloid
+	}
+}
+
+// WithMarketBuilder routes a market order's builder fee to info.
+func WithMarketBuilder(info *BuilderInfo) MarketOrderOpt {
+	return func(p *marketOrderParams) {
+		p.builder = info
+	}
+}
+
+// MarketBuy buys sz of coin at the current price plus slippage tolerance
+// (defaultMarketSlippage unless overridden by WithSlippage), routing to
+// MarketOpen.
+func (e *Exchange) MarketBuy(
+	ctx context.Context,
+	coin string,
+	sz float64,
+	opts ...MarketOrderOpt,
+) (OrderStatus, error) {
+	return e.marketOrder(ctx, coin, true, sz, opts...)
+}
+
+// MarketSell sells sz of coin at the current price minus slippage
+// tolerance (defaultMarketSlippage unless overridden by WithSlippage),
+// routing to MarketOpen.
+func (e *Exchange) MarketSell(
+	ctx context.Context,
+	coin string,
+	sz float64,
+	opts ...MarketOrderOpt,
+) (OrderStatus, error) {
+	return e.marketOrder(ctx, coin, false, sz, opts...)
+}
+
+func (e *Exchange) marketOrder(
+	ctx context.Context,
+	coin string,
+	isBuy bool,
+	sz float64,
+	opts ...MarketOrderOpt,
+) (OrderStatus, error) {
+	p := marketOrderParams{slippage: defaultMarketSlippage}
+	for _, opt := range opts {
+		opt(&p)
+	}
+
+	return e.MarketOpen(ctx, coin, isBuy, sz, p.px, p.slippage, p.cloid, p.builder)
+}
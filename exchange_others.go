@@ -9,6 +9,7 @@ import (
 	"sort"
 
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/sonirico/go-hyperliquid/actions"
 )
 
 func (e *Exchange) UpdateLeverage(leverage int, name string, isCross bool) (*UserState, error) {
@@ -67,7 +68,7 @@ func (e *Exchange) SlippagePrice(
 	slippage float64,
 	px *float64,
 ) (float64, error) {
-	coin := e.info.nameToCoin[name]
+	coin := e.info.coinFor(name)
 	var price float64
 
 	if px != nil {
@@ -85,7 +86,7 @@ func (e *Exchange) SlippagePrice(
 		}
 	}
 
-	asset := e.info.coinToAsset[coin]
+	asset, _ := e.info.CoinToAsset(coin)
 	isSpot := asset >= 10000
 
 	// Calculate slippage
@@ -106,7 +107,7 @@ func (e *Exchange) SlippagePrice(
 	if isSpot {
 		decimals = 8
 	}
-	szDecimals := e.info.assetToDecimal[asset]
+	szDecimals, _ := e.info.AssetToDecimal(asset)
 
 	return roundToDecimals(price, decimals-szDecimals), nil
 }
@@ -123,8 +124,9 @@ func (e *Exchange) ScheduleCancelWithContext(ctx context.Context, scheduleTime *
 		Time: scheduleTime,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
+	sig, err := SignL1ActionWithSigner(
+		ctx,
+		e.signerOrDefault(),
 		action,
 		e.vault,
 		nonce,
@@ -159,8 +161,9 @@ func (e *Exchange) SetReferrerWithContext(ctx context.Context, code string) (*Se
 		Code: code,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
+	sig, err := SignL1ActionWithSigner(
+		ctx,
+		e.signerOrDefault(),
 		action,
 		"", // No vault address for referrer
 		nonce,
@@ -195,8 +198,9 @@ func (e *Exchange) CreateSubAccountWithContext(ctx context.Context, name string)
 		Name: name,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
+	sig, err := SignL1ActionWithSigner(
+		ctx,
+		e.signerOrDefault(),
 		action,
 		"", // No vault address for sub-account creation
 		nonce,
@@ -238,8 +242,9 @@ func (e *Exchange) UsdClassTransferWithContext(ctx context.Context, amount float
 		Nonce:  nonce,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
+	sig, err := SignL1ActionWithSigner(
+		ctx,
+		e.signerOrDefault(),
 		action,
 		e.vault,
 		nonce,
@@ -280,8 +285,9 @@ func (e *Exchange) SubAccountTransferWithContext(ctx context.Context,
 		Usd:            usd,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
+	sig, err := SignL1ActionWithSigner(
+		ctx,
+		e.signerOrDefault(),
 		action,
 		"", // No vault address
 		nonce,
@@ -322,8 +328,9 @@ func (e *Exchange) VaultUsdTransferWithContext(ctx context.Context,
 		Usd:          usd,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
+	sig, err := SignL1ActionWithSigner(
+		ctx,
+		e.signerOrDefault(),
 		action,
 		"", // No vault address
 		nonce,
@@ -334,7 +341,7 @@ func (e *Exchange) VaultUsdTransferWithContext(ctx context.Context,
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postActionIdempotent(ctx, action, sig, nonce)
 	if err != nil {
 		return nil, err
 	}
@@ -364,8 +371,9 @@ func (e *Exchange) CreateVaultWithContext(ctx context.Context,
 		InitialUsd:  initialUsd,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
+	sig, err := SignL1ActionWithSigner(
+		ctx,
+		e.signerOrDefault(),
 		action,
 		"", // No vault address
 		nonce,
@@ -404,8 +412,9 @@ func (e *Exchange) VaultModifyWithContext(ctx context.Context,
 		AlwaysCloseOnWithdraw: alwaysCloseOnWithdraw,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
+	sig, err := SignL1ActionWithSigner(
+		ctx,
+		e.signerOrDefault(),
 		action,
 		"", // No vault address
 		nonce,
@@ -439,8 +448,9 @@ func (e *Exchange) VaultDistributeWithContext(ctx context.Context, vaultAddress
 		Usd:          usd,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
+	sig, err := SignL1ActionWithSigner(
+		ctx,
+		e.signerOrDefault(),
 		action,
 		"", // No vault address
 		nonce,
@@ -477,8 +487,9 @@ func (e *Exchange) UsdTransferWithContext(ctx context.Context, amount float64, d
 		Time:        nonce,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
+	sig, err := SignL1ActionWithSigner(
+		ctx,
+		e.signerOrDefault(),
 		action,
 		e.vault,
 		nonce,
@@ -489,7 +500,7 @@ func (e *Exchange) UsdTransferWithContext(ctx context.Context, amount float64, d
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postActionIdempotent(ctx, action, sig, nonce)
 	if err != nil {
 		return nil, err
 	}
@@ -519,8 +530,9 @@ func (e *Exchange) SpotTransferWithContext(ctx context.Context,
 		Time:        nonce,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
+	sig, err := SignL1ActionWithSigner(
+		ctx,
+		e.signerOrDefault(),
 		action,
 		e.vault,
 		nonce,
@@ -556,8 +568,9 @@ func (e *Exchange) UseBigBlocksWithContext(ctx context.Context, enable bool) (*A
 		UsingBigBlocks: enable,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
+	sig, err := SignL1ActionWithSigner(
+		ctx,
+		e.signerOrDefault(),
 		action,
 		"", // No vault address
 		nonce,
@@ -600,8 +613,9 @@ func (e *Exchange) PerpDexClassTransferWithContext(ctx context.Context,
 		ToPerp: toPerp,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
+	sig, err := SignL1ActionWithSigner(
+		ctx,
+		e.signerOrDefault(),
 		action,
 		e.vault,
 		nonce,
@@ -645,8 +659,9 @@ func (e *Exchange) SubAccountSpotTransferWithContext(ctx context.Context,
 		Amount:         amount,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
+	sig, err := SignL1ActionWithSigner(
+		ctx,
+		e.signerOrDefault(),
 		action,
 		e.vault,
 		nonce,
@@ -689,8 +704,9 @@ func (e *Exchange) TokenDelegateWithContext(ctx context.Context,
 		Nonce:        nonce,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
+	sig, err := SignL1ActionWithSigner(
+		ctx,
+		e.signerOrDefault(),
 		action,
 		e.vault,
 		nonce,
@@ -701,7 +717,7 @@ func (e *Exchange) TokenDelegateWithContext(ctx context.Context,
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postActionIdempotent(ctx, action, sig, nonce)
 	if err != nil {
 		return nil, err
 	}
@@ -718,6 +734,18 @@ func (e *Exchange) WithdrawFromBridge(amount float64, destination string) (*Tran
 	return e.WithdrawFromBridgeWithContext(context.Background(), amount, destination)
 }
 
+// Withdraw is an alias for WithdrawFromBridge, matching the name used in
+// Hyperliquid's own API docs for this action.
+func (e *Exchange) Withdraw(amount float64, destination string) (*TransferResponse, error) {
+	return e.WithdrawFromBridgeWithContext(context.Background(), amount, destination)
+}
+
+// WithdrawWithContext is an alias for WithdrawFromBridgeWithContext, matching
+// the name used in Hyperliquid's own API docs for this action.
+func (e *Exchange) WithdrawWithContext(ctx context.Context, amount float64, destination string) (*TransferResponse, error) {
+	return e.WithdrawFromBridgeWithContext(ctx, amount, destination)
+}
+
 func (e *Exchange) WithdrawFromBridgeWithContext(ctx context.Context,
 	amount float64,
 	destination string,
@@ -731,8 +759,9 @@ func (e *Exchange) WithdrawFromBridgeWithContext(ctx context.Context,
 		Time:        nonce,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
+	sig, err := SignL1ActionWithSigner(
+		ctx,
+		e.signerOrDefault(),
 		action,
 		e.vault,
 		nonce,
@@ -743,7 +772,7 @@ func (e *Exchange) WithdrawFromBridgeWithContext(ctx context.Context,
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
+	resp, err := e.postActionIdempotent(ctx, action, sig, nonce)
 	if err != nil {
 		return nil, err
 	}
@@ -783,8 +812,9 @@ func (e *Exchange) ApproveAgentWithContext(ctx context.Context, name *string) (*
 		Nonce:        nonce,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
+	sig, err := SignL1ActionWithSigner(
+		ctx,
+		e.signerOrDefault(),
 		action,
 		e.vault,
 		nonce,
@@ -822,8 +852,9 @@ func (e *Exchange) ApproveBuilderFeeWithContext(ctx context.Context, builder str
 		Nonce:      nonce,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
+	sig, err := SignL1ActionWithSigner(
+		ctx,
+		e.signerOrDefault(),
 		action,
 		e.vault,
 		nonce,
@@ -843,6 +874,12 @@ func (e *Exchange) ApproveBuilderFeeWithContext(ctx context.Context, builder str
 	if err := json.Unmarshal(resp, &result); err != nil {
 		return nil, err
 	}
+
+	if result.Status == "ok" {
+		e.builderAddr = builder
+		e.builderFeeRate = maxFeeRate
+	}
+
 	return &result, nil
 }
 
@@ -876,8 +913,9 @@ func (e *Exchange) ConvertToMultiSigUserWithContext(ctx context.Context,
 		Nonce:   nonce,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
+	sig, err := SignL1ActionWithSigner(
+		ctx,
+		e.signerOrDefault(),
 		action,
 		e.vault,
 		nonce,
@@ -929,8 +967,9 @@ func (e *Exchange) SpotDeployRegisterTokenWithContext(ctx context.Context,
 		},
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
+	sig, err := SignL1ActionWithSigner(
+		ctx,
+		e.signerOrDefault(),
 		action,
 		"", // No vault address for spot deploy
 		nonce,
@@ -966,8 +1005,9 @@ func (e *Exchange) SpotDeployUserGenesisWithContext(ctx context.Context, balance
 		"balances": balances,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
+	sig, err := SignL1ActionWithSigner(
+		ctx,
+		e.signerOrDefault(),
 		action,
 		e.vault,
 		nonce,
@@ -1002,8 +1042,9 @@ func (e *Exchange) SpotDeployEnableFreezePrivilegeWithContext(ctx context.Contex
 		"type": "spotDeployEnableFreezePrivilege",
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
+	sig, err := SignL1ActionWithSigner(
+		ctx,
+		e.signerOrDefault(),
 		action,
 		e.vault,
 		nonce,
@@ -1031,36 +1072,26 @@ func (e *Exchange) SpotDeployFreezeUser(userAddress string) (*SpotDeployResponse
 	return e.SpotDeployFreezeUserWithContext(context.Background(), userAddress)
 }
 
-func (e *Exchange) SpotDeployFreezeUserWithContext(ctx context.Context, userAddress string) (*SpotDeployResponse, error) {
-	nonce := e.nextNonce()
-
-	action := map[string]any{
-		"type":        "spotDeployFreezeUser",
-		"userAddress": userAddress,
-	}
-
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		nonce,
-		e.expiresAfter,
-		e.client.baseURL == MainnetAPIURL,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := e.postAction(ctx, action, sig, nonce)
+// SpotDeployFreezeUserWithContext optionally blocks, via a trailing
+// ActionWaitOpts, until Info confirms userAddress's frozen flag actually
+// flipped on-chain rather than only reflecting the RPC ack.
+func (e *Exchange) SpotDeployFreezeUserWithContext(
+	ctx context.Context,
+	userAddress string,
+	wait ...ActionWaitOpts,
+) (*SpotDeployResponse, error) {
+	result, nonce, err := DoWithNonce[SpotDeployResponse](ctx, e, actions.SpotDeployFreezeUser{UserAddress: userAddress})
 	if err != nil {
 		return nil, err
 	}
 
-	var result SpotDeployResponse
-	if err := json.Unmarshal(resp, &result); err != nil {
-		return nil, err
+	if opts := firstActionWaitOpts(wait); opts.WaitForReceipt {
+		confirmer := SpotDeployFreezeUserConfirmer{Deployer: e.accountAddr, UserAddress: userAddress}
+		if _, err := e.WaitForAction(ctx, nonce, confirmer, opts.Poll); err != nil {
+			return nil, fmt.Errorf("wait for spot deploy freeze user receipt: %w", err)
+		}
 	}
-	return &result, nil
+	return result, nil
 }
 
 // SpotDeployRevokeFreezePrivilege revokes freeze privilege for spot deployer
@@ -1075,8 +1106,9 @@ func (e *Exchange) SpotDeployRevokeFreezePrivilegeWithContext(ctx context.Contex
 		"type": "spotDeployRevokeFreezePrivilege",
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
+	sig, err := SignL1ActionWithSigner(
+		ctx,
+		e.signerOrDefault(),
 		action,
 		e.vault,
 		nonce,
@@ -1104,37 +1136,27 @@ func (e *Exchange) SpotDeployGenesis(deployer string, dexName string) (*SpotDepl
 	return e.SpotDeployGenesisWithContext(context.Background(), deployer, dexName)
 }
 
-func (e *Exchange) SpotDeployGenesisWithContext(ctx context.Context, deployer string, dexName string) (*SpotDeployResponse, error) {
-	nonce := e.nextNonce()
-
-	action := map[string]any{
-		"type":     "spotDeployGenesis",
-		"deployer": deployer,
-		"dexName":  dexName,
-	}
-
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		nonce,
-		e.expiresAfter,
-		e.client.baseURL == MainnetAPIURL,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := e.postAction(ctx, action, sig, nonce)
+// SpotDeployGenesisWithContext optionally blocks, via a trailing
+// ActionWaitOpts, until Info confirms genesis for dexName has actually been
+// observed on-chain rather than only reflecting the RPC ack.
+func (e *Exchange) SpotDeployGenesisWithContext(
+	ctx context.Context,
+	deployer string,
+	dexName string,
+	wait ...ActionWaitOpts,
+) (*SpotDeployResponse, error) {
+	result, nonce, err := DoWithNonce[SpotDeployResponse](ctx, e, actions.SpotDeployGenesis{Deployer: deployer, DexName: dexName})
 	if err != nil {
 		return nil, err
 	}
 
-	var result SpotDeployResponse
-	if err := json.Unmarshal(resp, &result); err != nil {
-		return nil, err
+	if opts := firstActionWaitOpts(wait); opts.WaitForReceipt {
+		confirmer := SpotDeployGenesisConfirmer{Deployer: deployer}
+		if _, err := e.WaitForAction(ctx, nonce, confirmer, opts.Poll); err != nil {
+			return nil, fmt.Errorf("wait for spot deploy genesis receipt: %w", err)
+		}
 	}
-	return &result, nil
+	return result, nil
 }
 
 // SpotDeployRegisterSpot registers spot market
@@ -1154,8 +1176,9 @@ func (e *Exchange) SpotDeployRegisterSpotWithContext(ctx context.Context,
 		"quoteToken": quoteToken,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
+	sig, err := SignL1ActionWithSigner(
+		ctx,
+		e.signerOrDefault(),
 		action,
 		e.vault,
 		nonce,
@@ -1195,8 +1218,9 @@ func (e *Exchange) SpotDeployRegisterHyperliquidityWithContext(ctx context.Conte
 		"tokens": tokens,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
+	sig, err := SignL1ActionWithSigner(
+		ctx,
+		e.signerOrDefault(),
 		action,
 		e.vault,
 		nonce,
@@ -1234,8 +1258,9 @@ func (e *Exchange) SpotDeploySetDeployerTradingFeeShareWithContext(ctx context.C
 		"feeShare": feeShare,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
+	sig, err := SignL1ActionWithSigner(
+		ctx,
+		e.signerOrDefault(),
 		action,
 		e.vault,
 		nonce,
@@ -1269,36 +1294,10 @@ func (e *Exchange) PerpDeployRegisterAssetWithContext(ctx context.Context,
 	asset string,
 	perpDexInput PerpDexSchemaInput,
 ) (*PerpDeployResponse, error) {
-	nonce := e.nextNonce()
-
-	action := map[string]any{
-		"type":         "perpDeployRegisterAsset",
-		"asset":        asset,
-		"perpDexInput": perpDexInput,
-	}
-
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		nonce,
-		e.expiresAfter,
-		e.client.baseURL == MainnetAPIURL,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := e.postAction(ctx, action, sig, nonce)
-	if err != nil {
-		return nil, err
-	}
-
-	var result PerpDeployResponse
-	if err := json.Unmarshal(resp, &result); err != nil {
-		return nil, err
-	}
-	return &result, nil
+	return Do[PerpDeployResponse](ctx, e, actions.PerpDeployRegisterAsset{
+		Asset:        asset,
+		PerpDexInput: perpDexInput,
+	})
 }
 
 // PerpDeploySetOracle sets oracle for perpetual asset
@@ -1318,8 +1317,9 @@ func (e *Exchange) PerpDeploySetOracleWithContext(ctx context.Context,
 		"oracleAddress": oracleAddress,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
+	sig, err := SignL1ActionWithSigner(
+		ctx,
+		e.signerOrDefault(),
 		action,
 		e.vault,
 		nonce,
@@ -1356,8 +1356,9 @@ func (e *Exchange) CSignerUnjailSelfWithContext(ctx context.Context) (*Validator
 		"type": "cSignerUnjailSelf",
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
+	sig, err := SignL1ActionWithSigner(
+		ctx,
+		e.signerOrDefault(),
 		action,
 		e.vault,
 		nonce,
@@ -1386,34 +1387,7 @@ func (e *Exchange) CSignerJailSelf() (*ValidatorResponse, error) {
 }
 
 func (e *Exchange) CSignerJailSelfWithContext(ctx context.Context) (*ValidatorResponse, error) {
-	nonce := e.nextNonce()
-
-	action := map[string]any{
-		"type": "cSignerJailSelf",
-	}
-
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		nonce,
-		e.expiresAfter,
-		e.client.baseURL == MainnetAPIURL,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := e.postAction(ctx, action, sig, nonce)
-	if err != nil {
-		return nil, err
-	}
-
-	var result ValidatorResponse
-	if err := json.Unmarshal(resp, &result); err != nil {
-		return nil, err
-	}
-	return &result, nil
+	return Do[ValidatorResponse](ctx, e, actions.CSignerJailSelf{})
 }
 
 // CSignerInner executes inner consensus signer action
@@ -1429,8 +1403,9 @@ func (e *Exchange) CSignerInnerWithContext(ctx context.Context, innerAction map[
 		"innerAction": innerAction,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
+	sig, err := SignL1ActionWithSigner(
+		ctx,
+		e.signerOrDefault(),
 		action,
 		e.vault,
 		nonce,
@@ -1460,36 +1435,25 @@ func (e *Exchange) CValidatorRegister(validatorProfile map[string]any) (*Validat
 	return e.CValidatorRegisterWithContext(context.Background(), validatorProfile)
 }
 
-func (e *Exchange) CValidatorRegisterWithContext(ctx context.Context, validatorProfile map[string]any) (*ValidatorResponse, error) {
-	nonce := e.nextNonce()
-
-	action := map[string]any{
-		"type":             "cValidatorRegister",
-		"validatorProfile": validatorProfile,
-	}
-
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		nonce,
-		e.expiresAfter,
-		e.client.baseURL == MainnetAPIURL,
-	)
+// CValidatorRegisterWithContext optionally blocks, via a trailing
+// ActionWaitOpts, until the caller's address actually appears in Info's
+// active validator set rather than only reflecting the RPC ack.
+func (e *Exchange) CValidatorRegisterWithContext(
+	ctx context.Context,
+	validatorProfile map[string]any,
+	wait ...ActionWaitOpts,
+) (*ValidatorResponse, error) {
+	result, nonce, err := DoWithNonce[ValidatorResponse](ctx, e, actions.CValidatorRegister{ValidatorProfile: validatorProfile})
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(ctx, action, sig, nonce)
-	if err != nil {
-		return nil, err
-	}
-
-	var result ValidatorResponse
-	if err := json.Unmarshal(resp, &result); err != nil {
-		return nil, err
+	if opts := firstActionWaitOpts(wait); opts.WaitForReceipt {
+		if _, err := e.WaitForAction(ctx, nonce, CValidatorRegisterConfirmer{}, opts.Poll); err != nil {
+			return nil, fmt.Errorf("wait for validator register receipt: %w", err)
+		}
 	}
-	return &result, nil
+	return result, nil
 }
 
 // CValidatorChangeProfile changes validator profile
@@ -1505,8 +1469,9 @@ func (e *Exchange) CValidatorChangeProfileWithContext(ctx context.Context, newPr
 		"newProfile": newProfile,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
+	sig, err := SignL1ActionWithSigner(
+		ctx,
+		e.signerOrDefault(),
 		action,
 		e.vault,
 		nonce,
@@ -1541,8 +1506,9 @@ func (e *Exchange) CValidatorUnregisterWithContext(ctx context.Context) (*Valida
 		"type": "cValidatorUnregister",
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
+	sig, err := SignL1ActionWithSigner(
+		ctx,
+		e.signerOrDefault(),
 		action,
 		e.vault,
 		nonce,
@@ -1582,8 +1548,9 @@ func (e *Exchange) MultiSigWithContext(ctx context.Context,
 		"signatures": signatures,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
+	sig, err := SignL1ActionWithSigner(
+		ctx,
+		e.signerOrDefault(),
 		multiSigAction,
 		e.vault,
 		nonce,
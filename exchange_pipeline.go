@@ -0,0 +1,363 @@
+package hyperliquid
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// Endpoint names as used to key Limiters in PipelineOpts. These match the
+// request paths documented at
+// https://hyperliquid.gitbook.io/hyperliquid-docs/for-developers/api/rate-limits.
+const (
+	EndpointExchange = "/exchange"
+	EndpointInfo     = "/info"
+)
+
+// Limiter bounds how fast a weighted resource is consumed. Reserve blocks
+// (respecting ctx) until weight units are available, rather than
+// rejecting the caller outright, so a Pipeline flush cooperatively slows
+// down instead of tripping the exchange's own rate limiter.
+type Limiter interface {
+	Reserve(ctx context.Context, weight int) error
+}
+
+// TokenBucketLimiter is a Limiter backed by a classic token bucket:
+// RatePerSec tokens accrue per second up to Burst, and Reserve blocks
+// until enough tokens exist to cover weight.
+type TokenBucketLimiter struct {
+	mu         sync.Mutex
+	tokens     float64
+	burst      float64
+	ratePerSec float64
+	last       time.Time
+	nowFunc    func() time.Time
+}
+
+// NewTokenBucketLimiter returns a TokenBucketLimiter that accrues
+// ratePerSec tokens a second up to a maximum of burst.
+func NewTokenBucketLimiter(ratePerSec float64, burst int) *TokenBucketLimiter {
+	return &TokenBucketLimiter{
+		tokens:     float64(burst),
+		burst:      float64(burst),
+		ratePerSec: ratePerSec,
+		last:       time.Now(),
+		nowFunc:    time.Now,
+	}
+}
+
+// Reserve blocks until weight tokens are available, refilling the bucket
+// based on elapsed time on every call.
+func (l *TokenBucketLimiter) Reserve(ctx context.Context, weight int) error {
+	for {
+		l.mu.Lock()
+		now := l.nowFunc()
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.ratePerSec)
+		l.last = now
+
+		if l.tokens >= float64(weight) {
+			l.tokens -= float64(weight)
+			l.mu.Unlock()
+			return nil
+		}
+
+		wait := time.Duration((float64(weight) - l.tokens) / l.ratePerSec * float64(time.Second))
+		l.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+const (
+	// pipelineDefaultWindow is how long Pipeline waits to coalesce more
+	// orders/cancels before flushing a non-empty batch anyway.
+	pipelineDefaultWindow = 50 * time.Millisecond
+	// pipelineDefaultMaxBatch caps how many orders or cancels are folded
+	// into one bulkOrders/cancel action regardless of Window.
+	pipelineDefaultMaxBatch = 32
+)
+
+// PipelineOpts configures Exchange.Pipeline.
+type PipelineOpts struct {
+	// Window bounds how long a coalesced batch waits to fill before it is
+	// flushed regardless of size. Defaults to pipelineDefaultWindow when
+	// zero.
+	Window time.Duration
+	// MaxBatch caps how many orders (or cancels) are coalesced into one
+	// action before flushing early. Defaults to pipelineDefaultMaxBatch
+	// when zero.
+	MaxBatch int
+	// Limiters are consulted by endpoint name (EndpointExchange) before a
+	// coalesced batch is signed and posted, with the batch size as the
+	// weight. A nil entry, or a nil map, disables limiting for that
+	// endpoint.
+	Limiters map[string]Limiter
+}
+
+// PipelineResult is delivered on the channel returned by
+// Pipeline.SubmitOrder once the coalesced batch containing it has been
+// posted.
+type PipelineResult struct {
+	Status OrderStatus
+	Err    error
+}
+
+// PipelineCancelResult is delivered on the channel returned by
+// Pipeline.SubmitCancel once the coalesced batch containing it has been
+// posted.
+type PipelineCancelResult struct {
+	Err error
+}
+
+type pipelineOrderItem struct {
+	req    CreateOrderRequest
+	cloid  string
+	result chan PipelineResult
+}
+
+type pipelineCancelItem struct {
+	req    CancelOrderRequest
+	result chan PipelineCancelResult
+}
+
+// Pipeline coalesces orders and cancels submitted by possibly-concurrent
+// callers into batched bulkOrders/cancel actions, trading a little added
+// latency (bounded by PipelineOpts.Window) for far fewer signed requests
+// against EndpointExchange. Orders and cancels coalesce independently:
+// a flush posts at most one bulkOrders action and one cancel action.
+// Create one with Exchange.Pipeline.
+//
+// All orders coalesced into one bulkOrders action share a single
+// BuilderInfo (the one passed to SubmitOrder); Submit order requests
+// needing different builders through separate Pipelines.
+type Pipeline struct {
+	exchange *Exchange
+	opts     PipelineOpts
+
+	mu      sync.Mutex
+	builder *BuilderInfo
+	orders  []pipelineOrderItem
+	cancels []pipelineCancelItem
+	timer   *time.Timer
+	closed  bool
+}
+
+// Pipeline returns a Pipeline that coalesces orders/cancels submitted
+// through it according to opts.
+func (e *Exchange) Pipeline(opts PipelineOpts) *Pipeline {
+	if opts.Window <= 0 {
+		opts.Window = pipelineDefaultWindow
+	}
+	if opts.MaxBatch <= 0 {
+		opts.MaxBatch = pipelineDefaultMaxBatch
+	}
+	return &Pipeline{exchange: e, opts: opts}
+}
+
+// SubmitOrder adds req to the current batch, auto-assigning a client
+// order ID when req has neither ClientOrderID nor IdempotencyKey set, so
+// a resting status can be mapped back to the caller by cloid. A status
+// that carries no cloid (e.g. a filled order, or a req with only an
+// IdempotencyKey) is matched by its position in the batch instead.
+// The returned channel receives exactly one PipelineResult once the
+// batch containing req has been posted.
+func (p *Pipeline) SubmitOrder(req CreateOrderRequest, builder *BuilderInfo) <-chan PipelineResult {
+	result := make(chan PipelineResult, 1)
+
+	if req.ClientOrderID == nil && req.IdempotencyKey == "" {
+		cloid := randomCloid()
+		req.ClientOrderID = &cloid
+	}
+	cloid := ""
+	if req.ClientOrderID != nil {
+		cloid = *req.ClientOrderID
+	}
+
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		result <- PipelineResult{Err: fmt.Errorf("pipeline is closed")}
+		return result
+	}
+
+	if builder != nil {
+		p.builder = builder
+	}
+	p.orders = append(p.orders, pipelineOrderItem{req: req, cloid: cloid, result: result})
+	full := len(p.orders) >= p.opts.MaxBatch
+	p.armLocked()
+	p.mu.Unlock()
+
+	if full {
+		p.flushOrders(context.Background())
+	}
+
+	return result
+}
+
+// SubmitCancel adds req to the current cancel batch. The returned
+// channel receives exactly one PipelineCancelResult once the batch
+// containing req has been posted.
+func (p *Pipeline) SubmitCancel(req CancelOrderRequest) <-chan PipelineCancelResult {
+	result := make(chan PipelineCancelResult, 1)
+
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		result <- PipelineCancelResult{Err: fmt.Errorf("pipeline is closed")}
+		return result
+	}
+
+	p.cancels = append(p.cancels, pipelineCancelItem{req: req, result: result})
+	full := len(p.cancels) >= p.opts.MaxBatch
+	p.armLocked()
+	p.mu.Unlock()
+
+	if full {
+		p.flushCancels(context.Background())
+	}
+
+	return result
+}
+
+// armLocked starts the flush timer if one isn't already running. Callers
+// must hold p.mu.
+func (p *Pipeline) armLocked() {
+	if p.timer != nil {
+		return
+	}
+	p.timer = time.AfterFunc(p.opts.Window, func() {
+		p.flushOrders(context.Background())
+		p.flushCancels(context.Background())
+
+		p.mu.Lock()
+		p.timer = nil
+		p.mu.Unlock()
+	})
+}
+
+// flushOrders signs and posts every order accumulated so far as one
+// bulkOrders action, delivering each item's PipelineResult matched back
+// by the cloid of a resting status, or else by position in the batch.
+func (p *Pipeline) flushOrders(ctx context.Context) {
+	p.mu.Lock()
+	items := p.orders
+	builder := p.builder
+	p.orders = nil
+	p.mu.Unlock()
+
+	if len(items) == 0 {
+		return
+	}
+
+	if limiter := p.opts.Limiters[EndpointExchange]; limiter != nil {
+		if err := limiter.Reserve(ctx, len(items)); err != nil {
+			deliverOrderErr(items, fmt.Errorf("rate limiter: %w", err))
+			return
+		}
+	}
+
+	orders := make([]CreateOrderRequest, len(items))
+	for i, item := range items {
+		orders[i] = item.req
+	}
+
+	resp, err := p.exchange.BulkOrders(ctx, orders, builder)
+	if err != nil {
+		deliverOrderErr(items, err)
+		return
+	}
+
+	byCloid := make(map[string]OrderStatus, len(resp.Data.Statuses))
+	for _, status := range resp.Data.Statuses {
+		if status.Resting != nil && status.Resting.ClientID != nil {
+			byCloid[*status.Resting.ClientID] = status
+		}
+	}
+
+	for i, item := range items {
+		if status, ok := byCloid[item.cloid]; ok {
+			item.result <- PipelineResult{Status: status}
+			continue
+		}
+		if i < len(resp.Data.Statuses) {
+			item.result <- PipelineResult{Status: resp.Data.Statuses[i]}
+			continue
+		}
+		item.result <- PipelineResult{Err: fmt.Errorf("no status returned for cloid %s", item.cloid)}
+	}
+}
+
+// flushCancels signs and posts every cancel accumulated so far as one
+// cancel action.
+func (p *Pipeline) flushCancels(ctx context.Context) {
+	p.mu.Lock()
+	items := p.cancels
+	p.cancels = nil
+	p.mu.Unlock()
+
+	if len(items) == 0 {
+		return
+	}
+
+	if limiter := p.opts.Limiters[EndpointExchange]; limiter != nil {
+		if err := limiter.Reserve(ctx, len(items)); err != nil {
+			deliverCancelErr(items, fmt.Errorf("rate limiter: %w", err))
+			return
+		}
+	}
+
+	requests := make([]CancelOrderRequest, len(items))
+	for i, item := range items {
+		requests[i] = item.req
+	}
+
+	_, err := p.exchange.BulkCancel(ctx, requests)
+	for _, item := range items {
+		item.result <- PipelineCancelResult{Err: err}
+	}
+}
+
+func deliverOrderErr(items []pipelineOrderItem, err error) {
+	for _, item := range items {
+		item.result <- PipelineResult{Err: err}
+	}
+}
+
+func deliverCancelErr(items []pipelineCancelItem, err error) {
+	for _, item := range items {
+		item.result <- PipelineCancelResult{Err: err}
+	}
+}
+
+// Close flushes any pending orders/cancels and stops accepting new ones.
+// Submissions after Close return a channel that immediately yields an
+// error.
+func (p *Pipeline) Close() {
+	p.mu.Lock()
+	p.closed = true
+	if p.timer != nil {
+		p.timer.Stop()
+		p.timer = nil
+	}
+	p.mu.Unlock()
+
+	p.flushOrders(context.Background())
+	p.flushCancels(context.Background())
+}
+
+// randomCloid generates a random 16-byte client order ID in the 0x-hex
+// format normalizeCloid expects.
+func randomCloid() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return "0x" + hex.EncodeToString(b)
+}
@@ -0,0 +1,153 @@
+package hyperliquid
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// PriceQuoteOpts configures QuoteMarketOrder.
+type PriceQuoteOpts struct {
+	// Slippage is applied on top of the book-walked fill price as a final
+	// safety margin, the same semantics SlippagePrice already used.
+	Slippage float64
+	// MaxTotalFeeBps, if non-zero, makes QuoteMarketOrder return an error
+	// instead of a quote when BookImpactBps+TakerFeeBps+BuilderFeeBps
+	// would exceed it.
+	MaxTotalFeeBps float64
+}
+
+// SlippageBreakdown decomposes QuoteMarketOrder's ExpectedFillPrice into
+// the components that moved it away from MidPrice, so a caller can reject
+// an order whose true cost exceeds a threshold before signing anything.
+type SlippageBreakdown struct {
+	MidPrice          float64
+	BookImpactBps     float64
+	TakerFeeBps       float64
+	BuilderFeeBps     float64
+	ExpectedFillPrice float64
+}
+
+// QuoteMarketOrder prices a market order for size units of name, walking
+// the live L2 book depth to compute realized slippage rather than
+// assuming the mid price holds, and folding in the account's current
+// taker fee tier (via info.UserFees) and any builder fee approved through
+// ApproveBuilderFee. Use this ahead of MarketOpen/MarketClose to reject
+// orders whose true cost exceeds a threshold before signing.
+func (e *Exchange) QuoteMarketOrder(
+	ctx context.Context,
+	name string,
+	isBuy bool,
+	size float64,
+	opts PriceQuoteOpts,
+) (*SlippageBreakdown, error) {
+	book, err := e.info.L2SnapshotWithContext(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("fetch L2 book for %s: %w", name, err)
+	}
+
+	var bids, asks []Level
+	if len(book.Levels) > 0 {
+		bids = book.Levels[0]
+	}
+	if len(book.Levels) > 1 {
+		asks = book.Levels[1]
+	}
+	if len(bids) == 0 || len(asks) == 0 {
+		return nil, fmt.Errorf("book for %s has no liquidity on one side", name)
+	}
+	midPrice := (bids[0].Px + asks[0].Px) / 2
+
+	side := asks
+	if !isBuy {
+		side = bids
+	}
+	fillPrice, err := walkBookDepth(side, size)
+	if err != nil {
+		return nil, fmt.Errorf("walk book depth for %s: %w", name, err)
+	}
+
+	bookImpactBps := (fillPrice/midPrice - 1) * 10_000
+	if !isBuy {
+		bookImpactBps = -bookImpactBps
+	}
+
+	takerFeeBps, err := e.takerFeeBps(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetch taker fee: %w", err)
+	}
+	builderFeeBps := e.builderFeeBps()
+
+	if opts.MaxTotalFeeBps > 0 {
+		total := bookImpactBps + takerFeeBps + builderFeeBps
+		if total > opts.MaxTotalFeeBps {
+			return nil, fmt.Errorf(
+				"quote for %s exceeds max total fee: %.2f bps > %.2f bps",
+				name, total, opts.MaxTotalFeeBps,
+			)
+		}
+	}
+
+	feeFraction := (takerFeeBps + builderFeeBps) / 10_000
+	expected := fillPrice
+	if isBuy {
+		expected *= 1 + opts.Slippage + feeFraction
+	} else {
+		expected *= 1 - opts.Slippage - feeFraction
+	}
+
+	return &SlippageBreakdown{
+		MidPrice:          midPrice,
+		BookImpactBps:     bookImpactBps,
+		TakerFeeBps:       takerFeeBps,
+		BuilderFeeBps:     builderFeeBps,
+		ExpectedFillPrice: expected,
+	}, nil
+}
+
+// walkBookDepth returns the volume-weighted price needed to fill size
+// against levels, which must already be ordered best-price-first.
+func walkBookDepth(levels []Level, size float64) (float64, error) {
+	remaining := size
+	var notional float64
+
+	for _, lvl := range levels {
+		fill := lvl.Sz
+		if fill > remaining {
+			fill = remaining
+		}
+		notional += fill * lvl.Px
+		remaining -= fill
+		if remaining <= 0 {
+			break
+		}
+	}
+
+	if remaining > 0 {
+		return 0, fmt.Errorf("insufficient book depth to fill size %v", size)
+	}
+	return notional / size, nil
+}
+
+// takerFeeBps fetches the account's current taker fee tier. Hyperliquid
+// applies the same tiered account-level rate to both perp and spot fills,
+// so no separate spot lookup is needed here.
+func (e *Exchange) takerFeeBps(ctx context.Context) (float64, error) {
+	fees, err := e.info.UserFeesWithContext(ctx, e.accountAddr)
+	if err != nil {
+		return 0, err
+	}
+	return parseFloat(fees.UserCrossRate) * 10_000, nil
+}
+
+// builderFeeBps returns the fee rate most recently approved via
+// ApproveBuilderFee, or 0 if none has been approved. maxFeeRate is
+// expressed as a percentage string (e.g. "0.05%"), per approveBuilderFee's
+// wire format.
+func (e *Exchange) builderFeeBps() float64 {
+	if e.builderFeeRate == "" {
+		return 0
+	}
+	percent := parseFloat(strings.TrimSuffix(e.builderFeeRate, "%"))
+	return percent * 100
+}
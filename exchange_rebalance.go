@@ -0,0 +1,205 @@
+package hyperliquid
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// RebalanceOpts configures Exchange.Rebalance.
+type RebalanceOpts struct {
+	// DryRun, when true, returns the computed RebalancePlan without
+	// submitting any orders.
+	DryRun bool
+	// MinTradeUSD skips a coin's adjustment entirely when the delta
+	// between its current and target USD weight is smaller than this,
+	// so rebalancing doesn't churn on dust.
+	MinTradeUSD float64
+	// SellsFirst submits sell legs before buy legs, so selling frees
+	// margin/notional room for the buys in the same rebalance.
+	SellsFirst bool
+	// Slippage is passed to SlippagePrice when pricing each IOC limit
+	// order. Defaults to defaultMarketSlippage when zero.
+	Slippage float64
+	// Builder, if set, attaches a builder fee to the submitted orders.
+	Builder *BuilderInfo
+}
+
+// RebalanceTrade is one leg of a RebalancePlan: the order needed to move
+// coin from its current USD weight to its target USD weight. Result is nil
+// until Rebalance actually submits it (never, in DryRun mode).
+type RebalanceTrade struct {
+	Coin       string
+	IsBuy      bool
+	Size       float64
+	Price      float64
+	ReduceOnly bool
+	CurrentUSD float64
+	TargetUSD  float64
+	DeltaUSD   float64
+	Result     *BatchOrderResult
+}
+
+// RebalancePlan is the full set of trades Exchange.Rebalance computed (and,
+// outside DryRun, submitted) to move the account toward targets.
+type RebalancePlan struct {
+	AccountValue float64
+	Trades       []RebalanceTrade
+}
+
+// Rebalance reads the account's current positions and equity via
+// info.UserState, computes the order needed to move each coin in targets
+// (plus any currently-held coin missing from targets, which is treated as
+// a 0 target weight) to its target fraction of total account equity, and,
+// unless opts.DryRun is set, submits the resulting IOC limit orders via
+// BatchPlaceOrders - so a partially-filled or partially-rejected rebalance
+// still reports per-leg results instead of aborting the whole batch.
+//
+// Each order's size is snapped to its market's lot size (info.Meta's
+// szDecimals for the asset) and its limit price is computed via
+// SlippagePrice using opts.Slippage. A leg is marked ReduceOnly when it
+// moves the position toward zero without flipping its sign; a leg that
+// would flip a position from long to short (or vice versa) is never
+// reduce-only, since Hyperliquid rejects a reduce-only order that would
+// increase size in the new direction.
+func (e *Exchange) Rebalance(
+	ctx context.Context,
+	targets map[string]float64,
+	opts RebalanceOpts,
+) (RebalancePlan, error) {
+	address := e.accountAddr
+	if address == "" {
+		address = e.vault
+	}
+
+	userState, err := e.info.UserStateWithContext(ctx, address)
+	if err != nil {
+		return RebalancePlan{}, fmt.Errorf("rebalance: fetch user state: %w", err)
+	}
+
+	accountValue := parseFloat(userState.MarginSummary.AccountValue)
+	if accountValue <= 0 {
+		return RebalancePlan{}, fmt.Errorf("rebalance: non-positive account value")
+	}
+
+	mids, err := e.info.AllMidsWithContext(ctx)
+	if err != nil {
+		return RebalancePlan{}, fmt.Errorf("rebalance: fetch mid prices: %w", err)
+	}
+
+	currentSzi := make(map[string]float64, len(userState.AssetPositions))
+	for _, ap := range userState.AssetPositions {
+		currentSzi[ap.Position.Coin] = parseFloat(ap.Position.Szi)
+	}
+
+	coins := make(map[string]struct{}, len(targets)+len(currentSzi))
+	for coin := range targets {
+		coins[coin] = struct{}{}
+	}
+	for coin := range currentSzi {
+		coins[coin] = struct{}{}
+	}
+
+	slippage := opts.Slippage
+	if slippage == 0 {
+		slippage = defaultMarketSlippage
+	}
+
+	plan := RebalancePlan{AccountValue: accountValue}
+
+	for coin := range coins {
+		px := parseFloat(mids[coin])
+		if px <= 0 {
+			return RebalancePlan{}, fmt.Errorf("rebalance: no mid price for coin %s", coin)
+		}
+
+		curSzi := currentSzi[coin]
+		curUSD := curSzi * px
+		targetUSD := targets[coin] * accountValue
+		deltaUSD := targetUSD - curUSD
+
+		if math.Abs(deltaUSD) < opts.MinTradeUSD {
+			continue
+		}
+
+		asset, ok := e.info.CoinToAsset(coin)
+		if !ok {
+			return RebalancePlan{}, fmt.Errorf("rebalance: coin %s not found in info", coin)
+		}
+		szDecimals, _ := e.info.AssetToDecimal(asset)
+
+		targetSzi := targetUSD / px
+		deltaSzi := targetSzi - curSzi
+		size := roundToDecimals(math.Abs(deltaSzi), szDecimals)
+		if size <= 0 {
+			continue
+		}
+
+		isBuy := deltaSzi > 0
+
+		orderPx, err := e.SlippagePrice(ctx, coin, isBuy, slippage, nil)
+		if err != nil {
+			return RebalancePlan{}, fmt.Errorf("rebalance: price %s: %w", coin, err)
+		}
+
+		plan.Trades = append(plan.Trades, RebalanceTrade{
+			Coin:       coin,
+			IsBuy:      isBuy,
+			Size:       size,
+			Price:      orderPx,
+			ReduceOnly: reducesTowardZero(curSzi, targetSzi),
+			CurrentUSD: curUSD,
+			TargetUSD:  targetUSD,
+			DeltaUSD:   deltaUSD,
+		})
+	}
+
+	if opts.SellsFirst {
+		sort.SliceStable(plan.Trades, func(i, j int) bool {
+			return !plan.Trades[i].IsBuy && plan.Trades[j].IsBuy
+		})
+	}
+
+	if opts.DryRun || len(plan.Trades) == 0 {
+		return plan, nil
+	}
+
+	orders := make([]CreateOrderRequest, len(plan.Trades))
+	for i, trade := range plan.Trades {
+		orders[i] = CreateOrderRequest{
+			Coin:       trade.Coin,
+			IsBuy:      trade.IsBuy,
+			Price:      trade.Price,
+			Size:       trade.Size,
+			ReduceOnly: trade.ReduceOnly,
+			OrderType:  OrderType{Limit: &LimitOrderType{Tif: TifIoc}},
+		}
+	}
+
+	results, err := e.BatchPlaceOrders(ctx, orders, opts.Builder)
+	if err != nil {
+		return plan, fmt.Errorf("rebalance: submit orders: %w", err)
+	}
+
+	for i := range plan.Trades {
+		result := results[i]
+		plan.Trades[i].Result = &result
+	}
+
+	return plan, nil
+}
+
+// reducesTowardZero reports whether moving a position from curSzi to
+// targetSzi only shrinks it toward (or to) zero without flipping its sign -
+// the only case Hyperliquid allows a ReduceOnly order for.
+func reducesTowardZero(curSzi, targetSzi float64) bool {
+	if curSzi == 0 {
+		return false
+	}
+	if targetSzi == 0 {
+		return true
+	}
+	sameSign := (curSzi > 0) == (targetSzi > 0)
+	return sameSign && math.Abs(targetSzi) <= math.Abs(curSzi)
+}
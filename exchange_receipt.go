@@ -0,0 +1,179 @@
+package hyperliquid
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ActionReceiptStatus is the outcome Exchange.WaitForAction is polling for.
+type ActionReceiptStatus string
+
+const (
+	ActionReceiptPending   ActionReceiptStatus = "pending"
+	ActionReceiptConfirmed ActionReceiptStatus = "confirmed"
+)
+
+// ActionReceipt is the strongly-typed commit confirmation WaitForAction
+// returns once on-chain state reflects a previously-submitted action,
+// turning today's RPC-ack-only response types (SpotDeployResponse,
+// ValidatorResponse, …) into a proper confirmation.
+type ActionReceipt struct {
+	Nonce  int64
+	Status ActionReceiptStatus
+	Result any
+}
+
+// ActionConfirmer checks whether the on-chain state already reflects an
+// action this Exchange submitted, returning the strongly-typed result to
+// attach to the ActionReceipt once confirmed. Implementations live
+// alongside the action they confirm, e.g. SpotDeployGenesisConfirmer.
+type ActionConfirmer interface {
+	Confirm(ctx context.Context, info *Info, accountAddr string) (confirmed bool, result any, err error)
+}
+
+// WaitForActionOpts configures Exchange.WaitForAction's polling.
+type WaitForActionOpts struct {
+	// PollInterval between Confirm attempts. Defaults to 2s.
+	PollInterval time.Duration
+}
+
+func (o WaitForActionOpts) pollIntervalOrDefault() time.Duration {
+	if o.PollInterval > 0 {
+		return o.PollInterval
+	}
+	return 2 * time.Second
+}
+
+// WaitForAction polls confirmer until it reports the action submitted under
+// nonce has landed on-chain, or ctx is done. Modeled on go-ethereum
+// abigen's bind.WaitMined: a fire-and-forget POST becomes an observable
+// outcome the caller can block on.
+func (e *Exchange) WaitForAction(
+	ctx context.Context,
+	nonce int64,
+	confirmer ActionConfirmer,
+	opts WaitForActionOpts,
+) (*ActionReceipt, error) {
+	interval := opts.pollIntervalOrDefault()
+
+	for {
+		confirmed, result, err := confirmer.Confirm(ctx, e.info, e.accountAddr)
+		if err != nil {
+			return nil, fmt.Errorf("confirm action %d: %w", nonce, err)
+		}
+		if confirmed {
+			return &ActionReceipt{Nonce: nonce, Status: ActionReceiptConfirmed, Result: result}, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// ActionWaitOpts configures whether and how a *WithContext action method
+// blocks for on-chain confirmation before returning. It is accepted as a
+// trailing variadic parameter so existing call sites keep compiling
+// unchanged and default to the prior fire-and-forget behavior.
+type ActionWaitOpts struct {
+	// WaitForReceipt, if true, blocks until the action's ActionConfirmer
+	// reports it has landed on-chain.
+	WaitForReceipt bool
+	// Poll configures the underlying WaitForAction poll loop.
+	Poll WaitForActionOpts
+}
+
+// firstActionWaitOpts returns opts[0], or the zero value (wait disabled) if
+// the caller passed none.
+func firstActionWaitOpts(opts []ActionWaitOpts) ActionWaitOpts {
+	if len(opts) == 0 {
+		return ActionWaitOpts{}
+	}
+	return opts[0]
+}
+
+// ActionEvent is one observed outcome from Exchange.SubscribeActions.
+type ActionEvent struct {
+	Nonce  int64
+	Type   string
+	Status ActionReceiptStatus
+	Result any
+	Err    error
+}
+
+// trackedAction is one action registered via TrackAction, awaiting
+// confirmation in SubscribeActions' poll loop.
+type trackedAction struct {
+	actionType string
+	confirmer  ActionConfirmer
+}
+
+// TrackAction registers a pending action for SubscribeActions to poll via
+// confirmer until it lands, after which it is removed from tracking.
+func (e *Exchange) TrackAction(nonce int64, actionType string, confirmer ActionConfirmer) {
+	e.trackedActionsMu.Lock()
+	defer e.trackedActionsMu.Unlock()
+	if e.trackedActions == nil {
+		e.trackedActions = make(map[int64]trackedAction)
+	}
+	e.trackedActions[nonce] = trackedAction{actionType: actionType, confirmer: confirmer}
+}
+
+// SubscribeActions returns a channel that emits an ActionEvent for every
+// action registered via TrackAction once its ActionConfirmer reports it has
+// landed. Hyperliquid has no public WebSocket feed of generic L1 action
+// outcomes — orderUpdates and userFills cover trading, but not
+// deploy/validator/freeze actions — so this polls each tracked action's
+// confirmer on opts' interval rather than tailing a socket. The channel is
+// closed when ctx is done.
+func (e *Exchange) SubscribeActions(ctx context.Context, opts WaitForActionOpts) <-chan ActionEvent {
+	events := make(chan ActionEvent)
+	interval := opts.pollIntervalOrDefault()
+
+	go func() {
+		defer close(events)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				e.pollTrackedActions(ctx, events)
+			}
+		}
+	}()
+
+	return events
+}
+
+func (e *Exchange) pollTrackedActions(ctx context.Context, events chan<- ActionEvent) {
+	e.trackedActionsMu.Lock()
+	pending := make(map[int64]trackedAction, len(e.trackedActions))
+	for nonce, t := range e.trackedActions {
+		pending[nonce] = t
+	}
+	e.trackedActionsMu.Unlock()
+
+	for nonce, t := range pending {
+		confirmed, result, err := t.confirmer.Confirm(ctx, e.info, e.accountAddr)
+		if err != nil {
+			events <- ActionEvent{Nonce: nonce, Type: t.actionType, Err: err}
+			continue
+		}
+		if !confirmed {
+			continue
+		}
+
+		events <- ActionEvent{
+			Nonce: nonce, Type: t.actionType, Status: ActionReceiptConfirmed, Result: result,
+		}
+		e.trackedActionsMu.Lock()
+		delete(e.trackedActions, nonce)
+		e.trackedActionsMu.Unlock()
+	}
+}
@@ -0,0 +1,66 @@
+package hyperliquid
+
+import (
+	"context"
+	"strings"
+)
+
+// SpotDeployGenesisConfirmer confirms a SpotDeployGenesis action has landed
+// by polling Info.SpotDeployStateWithContext for deployer until GenesisDone
+// is set.
+type SpotDeployGenesisConfirmer struct {
+	Deployer string
+}
+
+func (c SpotDeployGenesisConfirmer) Confirm(
+	ctx context.Context, info *Info, _ string,
+) (bool, any, error) {
+	state, err := info.SpotDeployStateWithContext(ctx, c.Deployer)
+	if err != nil {
+		return false, nil, err
+	}
+	return state.GenesisDone, state, nil
+}
+
+// SpotDeployFreezeUserConfirmer confirms a SpotDeployFreezeUser action has
+// landed by polling Info.SpotDeployStateWithContext until UserAddress
+// appears in deployer's frozen users list.
+type SpotDeployFreezeUserConfirmer struct {
+	Deployer    string
+	UserAddress string
+}
+
+func (c SpotDeployFreezeUserConfirmer) Confirm(
+	ctx context.Context, info *Info, _ string,
+) (bool, any, error) {
+	state, err := info.SpotDeployStateWithContext(ctx, c.Deployer)
+	if err != nil {
+		return false, nil, err
+	}
+	for _, u := range state.FrozenUsers {
+		if strings.EqualFold(u, c.UserAddress) {
+			return true, state, nil
+		}
+	}
+	return false, nil, nil
+}
+
+// CValidatorRegisterConfirmer confirms a CValidatorRegister action has
+// landed by polling Info.ValidatorSummariesWithContext until the account
+// appears in the active, unjailed validator set.
+type CValidatorRegisterConfirmer struct{}
+
+func (c CValidatorRegisterConfirmer) Confirm(
+	ctx context.Context, info *Info, accountAddr string,
+) (bool, any, error) {
+	summaries, err := info.ValidatorSummariesWithContext(ctx)
+	if err != nil {
+		return false, nil, err
+	}
+	for _, v := range summaries {
+		if strings.EqualFold(v.Validator, accountAddr) && !v.IsJailed {
+			return true, v, nil
+		}
+	}
+	return false, nil, nil
+}
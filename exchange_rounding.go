@@ -0,0 +1,165 @@
+package hyperliquid
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RoundingMode controls how RoundPrice/RoundSize, and order/modify action
+// construction, round Price/Size to an asset's allowed tick and lot.
+type RoundingMode int
+
+const (
+	// RoundNearest rounds to the nearest allowed tick/lot, the default.
+	RoundNearest RoundingMode = iota
+	// RoundConservative rounds in the direction that never crosses the
+	// caller's intent: buy prices round down, sell prices round up, and
+	// size always rounds down, so a rounded order never pays more, sells
+	// for less, or requests a larger size than the caller asked for.
+	RoundConservative
+)
+
+// ExchangeOptRoundingMode sets the RoundingMode RoundPrice/RoundSize, and
+// order/modify action construction, use to snap Price/Size to an asset's
+// allowed tick and lot. Defaults to RoundNearest.
+func ExchangeOptRoundingMode(mode RoundingMode) ExchangeOpt {
+	return func(e *Exchange) {
+		e.roundingMode = mode
+	}
+}
+
+// tickLotDecimals returns coin's allowed size decimals (its lot size) and
+// price decimals (6 for perps, 8 for spot, both from Hyperliquid's fixed
+// convention), derived from the Meta/SpotMeta already cached on e.info.
+func (e *Exchange) tickLotDecimals(coin string) (szDecimals, pxDecimals int, err error) {
+	asset, ok := e.info.CoinToAsset(coin)
+	if !ok {
+		return 0, 0, fmt.Errorf("coin %s not found in info", coin)
+	}
+
+	szDecimals, ok = e.info.AssetToDecimal(asset)
+	if !ok {
+		return 0, 0, fmt.Errorf("no size decimals cached for asset %d", asset)
+	}
+
+	pxDecimals = 6
+	if asset >= spotAssetIndexOffset {
+		pxDecimals = 8
+	}
+
+	return szDecimals, pxDecimals, nil
+}
+
+// RoundPrice rounds px to coin's allowed tick size (5 significant figures,
+// further rounded to pxDecimals-szDecimals decimal places, the same rule
+// Exchange.SlippagePrice and Exchange.Validate apply), per e.roundingMode.
+// isBuy only matters under RoundConservative, where buy prices round down
+// and sell prices round up so a rounded quote never pays more or sells for
+// less than the caller asked.
+func (e *Exchange) RoundPrice(coin string, px float64, isBuy bool) (float64, error) {
+	szDecimals, pxDecimals, err := e.tickLotDecimals(coin)
+	if err != nil {
+		return 0, err
+	}
+
+	sigFigs, err := roundToSignificantFigures(px, 5)
+	if err != nil {
+		return 0, fmt.Errorf("round price: %w", err)
+	}
+
+	decimals := pxDecimals - szDecimals
+
+	switch e.roundingMode {
+	case RoundConservative:
+		if isBuy {
+			return roundDownToDecimals(sigFigs, decimals), nil
+		}
+		return roundUpToDecimals(sigFigs, decimals), nil
+	default:
+		return roundToDecimals(sigFigs, decimals), nil
+	}
+}
+
+// RoundSize rounds sz to coin's allowed lot size. Under RoundConservative
+// it always rounds down, so a rounded order never requests more size than
+// the caller asked for.
+func (e *Exchange) RoundSize(coin string, sz float64) (float64, error) {
+	szDecimals, _, err := e.tickLotDecimals(coin)
+	if err != nil {
+		return 0, err
+	}
+
+	if e.roundingMode == RoundConservative {
+		return roundDownToDecimals(sz, szDecimals), nil
+	}
+	return roundToDecimals(sz, szDecimals), nil
+}
+
+// RoundPriceDecimal is RoundPrice operating on Price/Decimal end to end
+// instead of float64, so a price with more significant digits than
+// float64's 53-bit mantissa carries (or one built from an exact decimal
+// string via PriceFromString) isn't silently corrupted before rounding.
+func (e *Exchange) RoundPriceDecimal(coin string, px Price, isBuy bool) (Price, error) {
+	szDecimals, pxDecimals, err := e.tickLotDecimals(coin)
+	if err != nil {
+		return Price{}, err
+	}
+
+	sigFigs := px.RoundToSignificantFigures(5)
+	decimals := pxDecimals - szDecimals
+
+	if e.roundingMode == RoundConservative {
+		if isBuy {
+			return Price{roundDecimalDown(sigFigs, decimals)}, nil
+		}
+		return Price{roundDecimalUp(sigFigs, decimals)}, nil
+	}
+	return Price{sigFigs.RoundToDecimals(decimals)}, nil
+}
+
+// RoundSizeDecimal is RoundSize operating on Size/Decimal end to end.
+func (e *Exchange) RoundSizeDecimal(coin string, sz Size) (Size, error) {
+	szDecimals, _, err := e.tickLotDecimals(coin)
+	if err != nil {
+		return Size{}, err
+	}
+
+	if e.roundingMode == RoundConservative {
+		return Size{roundDecimalDown(sz.Decimal, szDecimals)}, nil
+	}
+	return Size{sz.RoundToDecimals(szDecimals)}, nil
+}
+
+// roundDecimalDown rounds d toward -infinity at decimals places. For a
+// positive d this matches roundDownToDecimals, which truncates toward zero;
+// for a negative d it does not.
+func roundDecimalDown(d Decimal, decimals int) Decimal {
+	rounded := d.RoundToDecimals(decimals)
+	if rounded.Cmp(d) > 0 {
+		step, _ := DecimalFromString(stepString(decimals))
+		rounded = rounded.Sub(step)
+	}
+	return rounded
+}
+
+// roundDecimalUp rounds d toward +infinity at decimals places. For a
+// positive d this matches roundUpToDecimals, which rounds away from zero;
+// for a negative d it does not.
+func roundDecimalUp(d Decimal, decimals int) Decimal {
+	rounded := d.RoundToDecimals(decimals)
+	if rounded.Cmp(d) < 0 {
+		step, _ := DecimalFromString(stepString(decimals))
+		rounded = rounded.Add(step)
+	}
+	return rounded
+}
+
+// stepString returns "1" scaled down by decimals places, e.g.
+// stepString(2) == "0.01", for use as the smallest representable step
+// when nudging a Decimal up or down past RoundToDecimals' nearest-rounding.
+func stepString(decimals int) string {
+	if decimals <= 0 {
+		return "1"
+	}
+	return "0." + strings.Repeat("0", decimals-1) + "1"
+}
@@ -0,0 +1,120 @@
+package hyperliquid
+
+import (
+	"context"
+	"fmt"
+)
+
+// TwapOrderRequest places a TWAP (time-weighted average price) order:
+// Size executes over DurationMinutes in evenly spaced slices instead of
+// all at once, the way CreateOrderRequest's limit/trigger types don't
+// cover.
+type TwapOrderRequest struct {
+	Coin            string
+	IsBuy           bool
+	Size            float64
+	ReduceOnly      bool
+	DurationMinutes int
+	Randomize       bool
+}
+
+type twapOrderWire struct {
+	Asset      int    `json:"a"`
+	IsBuy      bool   `json:"b"`
+	Size       string `json:"s"`
+	ReduceOnly bool   `json:"r"`
+	Minutes    int    `json:"m"`
+	Randomize  bool   `json:"t"`
+}
+
+type twapOrderAction struct {
+	Type string        `json:"type"`
+	Twap twapOrderWire `json:"twap"`
+}
+
+type twapOrderResponseData struct {
+	Status struct {
+		TwapID int64 `json:"twapId"`
+	} `json:"status"`
+}
+
+// PlaceTwap submits req as a new TWAP order and returns the identifiers
+// ModifyTwap/CancelTwap need to manage it afterward.
+func (e *Exchange) PlaceTwap(ctx context.Context, req TwapOrderRequest) (TwapOrder, error) {
+	asset, ok := e.info.CoinToAsset(req.Coin)
+	if !ok {
+		return TwapOrder{}, fmt.Errorf("coin %s not found in info", req.Coin)
+	}
+
+	sizeWire, err := floatToWire(req.Size)
+	if err != nil {
+		return TwapOrder{}, fmt.Errorf("failed to wire size: %w", err)
+	}
+
+	action := twapOrderAction{
+		Type: "twapOrder",
+		Twap: twapOrderWire{
+			Asset:      asset,
+			IsBuy:      req.IsBuy,
+			Size:       sizeWire,
+			ReduceOnly: req.ReduceOnly,
+			Minutes:    req.DurationMinutes,
+			Randomize:  req.Randomize,
+		},
+	}
+
+	resp := APIResponse[twapOrderResponseData]{}
+	if err := e.executeAction(ctx, action, &resp); err != nil {
+		return TwapOrder{}, fmt.Errorf("failed to place twap order: %w", err)
+	}
+	if !resp.Ok {
+		if resp.Err != "" {
+			return TwapOrder{}, classifyOrderError(resp.Err)
+		}
+		return TwapOrder{}, fmt.Errorf("failed to place twap order")
+	}
+
+	return TwapOrder{Asset: asset, TwapID: resp.Data.Status.TwapID}, nil
+}
+
+type twapCancelAction struct {
+	Type   string `json:"type"`
+	Asset  int    `json:"a"`
+	TwapID int64  `json:"t"`
+}
+
+type twapCancelResponseData struct{}
+
+// CancelTwap cancels the TWAP identified by order, stopping any
+// remaining slices from executing.
+func (e *Exchange) CancelTwap(ctx context.Context, order TwapOrder) error {
+	action := twapCancelAction{
+		Type:   "twapCancel",
+		Asset:  order.Asset,
+		TwapID: order.TwapID,
+	}
+
+	resp := APIResponse[twapCancelResponseData]{}
+	if err := e.executeAction(ctx, action, &resp); err != nil {
+		return fmt.Errorf("failed to cancel twap order: %w", err)
+	}
+	if !resp.Ok {
+		if resp.Err != "" {
+			return classifyOrderError(resp.Err)
+		}
+		return fmt.Errorf("failed to cancel twap order")
+	}
+	return nil
+}
+
+// ModifyTwap replaces the TWAP identified by order with a new one built
+// from req. Hyperliquid's TWAP action set has no in-place modify, so this
+// cancels order and places req as a new TWAP, the same composition
+// PlaceBracket uses to build a multi-order request out of single-order
+// primitives.
+func (e *Exchange) ModifyTwap(ctx context.Context, order TwapOrder, req TwapOrderRequest) (TwapOrder, error) {
+	if err := e.CancelTwap(ctx, order); err != nil {
+		return TwapOrder{}, fmt.Errorf("failed to cancel twap order before modify: %w", err)
+	}
+	return e.PlaceTwap(ctx, req)
+}
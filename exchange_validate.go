@@ -0,0 +1,109 @@
+package hyperliquid
+
+import (
+	"context"
+	"fmt"
+)
+
+// minOrderNotionalUSD is Hyperliquid's minimum order value (price * size),
+// the same limit the server enforces and returns as "Order must have
+// minimum value of $10." (see ErrBelowMinNotional).
+const minOrderNotionalUSD = 10.0
+
+// Validate checks a CreateOrderRequest against Hyperliquid's tick/lot/
+// minimum-notional rules using the Meta/SpotMeta already cached on e.info,
+// without a network round-trip. It rounds Price to the asset's allowed
+// tick and Size to its allowed lot the same way Exchange.SlippagePrice
+// does for market orders, then checks the rounded notional against
+// minOrderNotionalUSD. If RefreshPositionsCache has been called, ReduceOnly
+// orders are also checked against the cached position so a guaranteed-to-
+// increase reduce-only order is rejected locally instead of round-
+// tripping to the server first.
+//
+// Validate does not mutate order; callers who want the exact rounded wire
+// values can round Price/Size themselves using the same rules (see
+// roundToSignificantFigures/roundToDecimals in utils.go) before sending.
+func (e *Exchange) Validate(order CreateOrderRequest) error {
+	roundedPrice, err := e.RoundPrice(order.Coin, order.Price, order.IsBuy)
+	if err != nil {
+		return err
+	}
+	roundedSize, err := e.RoundSize(order.Coin, order.Size)
+	if err != nil {
+		return err
+	}
+
+	if roundedSize*roundedPrice < minOrderNotionalUSD {
+		return &OrderAPIError{
+			Sentinel: ErrBelowMinNotional,
+			Raw:      fmt.Sprintf("order notional %.8f below minimum value of $%.0f", roundedSize*roundedPrice, minOrderNotionalUSD),
+		}
+	}
+
+	if order.OrderType.Trigger != nil {
+		if order.OrderType.Trigger.TriggerPx <= 0 {
+			return &OrderAPIError{Sentinel: ErrInvalidTif, Raw: "trigger price must be positive"}
+		}
+		if order.OrderType.Trigger.Tpsl != "tp" && order.OrderType.Trigger.Tpsl != "sl" {
+			return &OrderAPIError{Sentinel: ErrInvalidTif, Raw: fmt.Sprintf("invalid tpsl %q", order.OrderType.Trigger.Tpsl)}
+		}
+	}
+
+	if order.ReduceOnly {
+		if err := e.validateReduceOnly(order); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateReduceOnly rejects order if it is ReduceOnly but, against the
+// cached position from RefreshPositionsCache, would increase rather than
+// reduce the position. It's a no-op (returns nil) when no cache entry
+// exists for order.Coin, since Validate must not fetch the position itself.
+func (e *Exchange) validateReduceOnly(order CreateOrderRequest) error {
+	e.positionsCacheMu.RLock()
+	szi, ok := e.positionsCache[order.Coin]
+	e.positionsCacheMu.RUnlock()
+	if !ok {
+		return nil
+	}
+
+	increasesLong := szi >= 0 && order.IsBuy
+	increasesShort := szi <= 0 && !order.IsBuy
+	if increasesLong || increasesShort {
+		return &OrderAPIError{
+			Sentinel: ErrReduceOnlyWouldIncrease,
+			Raw:      fmt.Sprintf("reduce-only order on %s would increase position (szi=%.8f)", order.Coin, szi),
+		}
+	}
+	return nil
+}
+
+// RefreshPositionsCache fetches the account's current positions and caches
+// each coin's signed size (Szi), so subsequent Validate calls can check
+// ReduceOnly orders locally. Call it again whenever positions may have
+// changed; Validate never refreshes it on its own.
+func (e *Exchange) RefreshPositionsCache(ctx context.Context) error {
+	address := e.accountAddr
+	if address == "" {
+		address = e.vault
+	}
+
+	userState, err := e.info.UserState(ctx, address)
+	if err != nil {
+		return fmt.Errorf("refresh positions cache: %w", err)
+	}
+
+	cache := make(map[string]float64, len(userState.AssetPositions))
+	for _, assetPos := range userState.AssetPositions {
+		cache[assetPos.Position.Coin] = parseFloat(assetPos.Position.Szi)
+	}
+
+	e.positionsCacheMu.Lock()
+	e.positionsCache = cache
+	e.positionsCacheMu.Unlock()
+
+	return nil
+}
@@ -0,0 +1,289 @@
+// Package hltest promotes the nonce/signature-scrubbing cassette matcher
+// that this module's own test suite uses internally (see
+// scrubHLJSON/hyperliquidJSONMatcher in exchange_orders_test.go) into a
+// reusable, exported helper, so SDK users can build VCR-style cassette
+// tests against their own strategies without re-implementing the
+// Hyperliquid action-envelope scrubbing logic.
+package hltest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	hyperliquid "github.com/sonirico/go-hyperliquid"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
+)
+
+// Opt configures a Matcher, following this module's functional-options
+// convention (see hyperliquid.Opt).
+type Opt = hyperliquid.Opt[Matcher]
+
+// Matcher compares recorded and live Hyperliquid exchange requests,
+// tolerating the parts of an action envelope that legitimately change
+// between recordings: nonce, signature, and, optionally, float-formatted
+// price/size strings.
+type Matcher struct {
+	nonceWindow     int64
+	normalizeFloats bool
+	replayOids      bool
+	nextOid         int64
+}
+
+// New builds a Matcher with Hyperliquid's default scrubbing behavior
+// (nonce and signature.{r,s,v} are ignored entirely), adjusted by opts.
+func New(opts ...Opt) *Matcher {
+	m := &Matcher{}
+	for _, opt := range opts {
+		opt.Apply(m)
+	}
+	return m
+}
+
+// WithNonceWindow makes the matcher require the live request's nonce to
+// fall within window of the recorded nonce, instead of ignoring nonce
+// entirely. Use this to catch a strategy accidentally replaying a stale
+// nonce while still tolerating the monotonic drift a normal run produces.
+func WithNonceWindow(window int64) Opt {
+	return func(m *Matcher) {
+		m.nonceWindow = window
+	}
+}
+
+// WithFloatNormalization makes the matcher compare price/size wire strings
+// by numeric value rather than exact text, so "40000" and "40000.0" (or
+// trailing-zero differences introduced by floatToWire across Go versions)
+// still match.
+func WithFloatNormalization() Opt {
+	return func(m *Matcher) {
+		m.normalizeFloats = true
+	}
+}
+
+// WithDeterministicOidReplay rewrites every "oid" field in a captured
+// response body to a sequential value starting at start, at capture time,
+// so re-recording a cassette against a live (or testnet) exchange doesn't
+// churn the committed fixture on every run, and downstream assertions
+// against OrderStatusResting.Oid stay stable across re-recordings.
+func WithDeterministicOidReplay(start int64) Opt {
+	return func(m *Matcher) {
+		m.replayOids = true
+		m.nextOid = start
+	}
+}
+
+// Func returns a cassette.MatcherFunc suitable for recorder.WithMatcher,
+// falling back to the library's default matcher for non-JSON requests.
+func (m *Matcher) Func() recorder.MatcherFunc {
+	def := cassette.NewDefaultMatcher(
+		cassette.WithIgnoreHeaders("Authorization", "Apikey", "Signature"),
+	)
+
+	return func(req *http.Request, rec cassette.Request) bool {
+		if req.Method != rec.Method || req.URL.String() != rec.URL {
+			return false
+		}
+
+		rec.Headers.Del("Authorization")
+		rec.Headers.Del("Apikey")
+		rec.Headers.Del("Signature")
+
+		if strings.Contains(rec.Headers.Get("Content-Type"), "application/json") {
+			live, recorded, ok := m.decodeBoth(req, rec.Body)
+			if !ok {
+				return def(req, rec)
+			}
+
+			if m.nonceWindow > 0 && !m.nonceWithinWindow(live, recorded) {
+				return false
+			}
+
+			return m.canonicalize(live) == m.canonicalize(recorded)
+		}
+
+		return def(req, rec)
+	}
+}
+
+// Hook returns a recorder.HookFunc suitable for recorder.WithHook, scrubbing
+// the request body (and, when replay is active, rewriting the response
+// body's oid fields to a deterministic value) before a cassette
+// interaction is persisted.
+func (m *Matcher) Hook() recorder.HookFunc {
+	return func(i *cassette.Interaction) error {
+		i.Request.Headers.Del("Authorization")
+		i.Request.Headers.Del("Apikey")
+		i.Request.Headers.Del("Signature")
+
+		if strings.Contains(i.Request.Headers.Get("Content-Type"), "application/json") &&
+			i.Request.Body != "" {
+			i.Request.Body = m.canonicalize(m.decode(i.Request.Body))
+		}
+
+		if m.replayOids && i.Response.Body != "" {
+			if body := m.decode(i.Response.Body); body != nil {
+				m.rewriteOids(body)
+				if b, err := json.Marshal(body); err == nil {
+					i.Response.Body = string(b)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+// rewriteOids walks v in place, replacing every "oid" field's numeric value
+// with the next deterministic id in sequence.
+func (m *Matcher) rewriteOids(v any) {
+	switch val := v.(type) {
+	case map[string]any:
+		for k, child := range val {
+			if k == "oid" {
+				if _, ok := child.(json.Number); ok {
+					val[k] = m.nextOid
+					m.nextOid++
+					continue
+				}
+			}
+			m.rewriteOids(child)
+		}
+	case []any:
+		for _, child := range val {
+			m.rewriteOids(child)
+		}
+	}
+}
+
+// ScrubJSON normalizes an action-envelope JSON body the same way Func and
+// Hook do: nonce and signature.{r,s,v} are dropped (also with
+// WithNonceWindow, whose nonce check runs in Func, not here), and, with
+// WithFloatNormalization, price/size strings are reformatted to their
+// canonical numeric form. Exported for callers who want to scrub a body
+// outside of the matcher/hook lifecycle, e.g. in a custom assertion.
+func (m *Matcher) ScrubJSON(body string) string {
+	return m.canonicalize(m.decode(body))
+}
+
+func (m *Matcher) decode(body string) map[string]any {
+	var parsed map[string]any
+	dec := json.NewDecoder(strings.NewReader(body))
+	dec.UseNumber() // keep numeric fidelity
+	if err := dec.Decode(&parsed); err != nil {
+		return nil
+	}
+	return parsed
+}
+
+func (m *Matcher) decodeBoth(req *http.Request, recordedBody string) (live, recorded map[string]any, ok bool) {
+	liveBody, err := readAndRestoreBody(req)
+	if err != nil {
+		return nil, nil, false
+	}
+
+	live = m.decode(liveBody)
+	recorded = m.decode(recordedBody)
+	if live == nil || recorded == nil {
+		return nil, nil, false
+	}
+	return live, recorded, true
+}
+
+func (m *Matcher) nonceWithinWindow(live, recorded map[string]any) bool {
+	liveNonce, ok1 := asInt64(live["nonce"])
+	recordedNonce, ok2 := asInt64(recorded["nonce"])
+	if !ok1 || !ok2 {
+		return true
+	}
+
+	drift := liveNonce - recordedNonce
+	if drift < 0 {
+		drift = -drift
+	}
+	return drift <= m.nonceWindow
+}
+
+// canonicalize scrubs volatile envelope fields and, optionally, normalizes
+// float-formatted strings, then re-marshals so map keys are sorted -
+// encoding/json's default map ordering is what makes the comparison
+// independent of field order.
+func (m *Matcher) canonicalize(parsed map[string]any) string {
+	if parsed == nil {
+		return ""
+	}
+
+	delete(parsed, "nonce")
+	if sig, ok := parsed["signature"].(map[string]any); ok {
+		delete(sig, "r")
+		delete(sig, "s")
+		delete(sig, "v")
+		if len(sig) == 0 {
+			delete(parsed, "signature")
+		} else {
+			parsed["signature"] = sig
+		}
+	}
+
+	if m.normalizeFloats {
+		normalizeFloatStrings(parsed)
+	}
+
+	b, err := json.Marshal(parsed)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+// normalizeFloatStrings walks v in place, reformatting any object field
+// value that is a string parsing as a float (Hyperliquid's px/sz wire
+// strings) to its canonical minimal decimal form, so "40000" and "40000.0"
+// compare equal. Strings that are direct array elements are left as is.
+func normalizeFloatStrings(v any) {
+	switch val := v.(type) {
+	case map[string]any:
+		for k, child := range val {
+			if s, ok := child.(string); ok {
+				if f, err := strconv.ParseFloat(s, 64); err == nil {
+					val[k] = strconv.FormatFloat(f, 'f', -1, 64)
+					continue
+				}
+			}
+			normalizeFloatStrings(child)
+		}
+	case []any:
+		for _, child := range val {
+			normalizeFloatStrings(child)
+		}
+	}
+}
+
+// readAndRestoreBody reads req.Body and replaces it with a fresh reader over
+// the same bytes, so the real transport can still read it after matching.
+func readAndRestoreBody(req *http.Request) (string, error) {
+	if req.Body == nil {
+		return "", nil
+	}
+	b, err := io.ReadAll(req.Body)
+	if err != nil {
+		return "", err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(b))
+	return string(b), nil
+}
+
+func asInt64(v any) (int64, bool) {
+	num, ok := v.(json.Number)
+	if !ok {
+		return 0, false
+	}
+	n, err := num.Int64()
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
@@ -0,0 +1,34 @@
+package hltest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatcherScrubJSONDropsNonceAndSignature(t *testing.T) {
+	m := New()
+
+	a := m.ScrubJSON(`{"action":{"type":"order"},"nonce":1,"signature":{"r":"0x1","s":"0x2","v":27}}`)
+	b := m.ScrubJSON(`{"action":{"type":"order"},"nonce":2,"signature":{"r":"0x3","s":"0x4","v":28}}`)
+
+	assert.Equal(t, a, b)
+}
+
+func TestMatcherFloatNormalization(t *testing.T) {
+	m := New(WithFloatNormalization())
+
+	a := m.ScrubJSON(`{"action":{"px":"40000"}}`)
+	b := m.ScrubJSON(`{"action":{"px":"40000.0"}}`)
+
+	assert.Equal(t, a, b)
+}
+
+func TestMatcherFloatNormalizationDisabledByDefault(t *testing.T) {
+	m := New()
+
+	a := m.ScrubJSON(`{"action":{"px":"40000"}}`)
+	b := m.ScrubJSON(`{"action":{"px":"40000.0"}}`)
+
+	assert.NotEqual(t, a, b)
+}
@@ -0,0 +1,315 @@
+package hyperliquid
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// IdempotencyStatus is the last known outcome of one (address, nonce)
+// action tracked by an IdempotencyStore.
+type IdempotencyStatus string
+
+const (
+	// IdempotencyStatusPending means the POST was sent but its outcome is
+	// not yet known (in flight, or the process crashed before recording
+	// a result).
+	IdempotencyStatusPending IdempotencyStatus = "pending"
+	// IdempotencyStatusLanded means the action was accepted and Response
+	// holds the body to replay on retry instead of re-signing/re-posting.
+	IdempotencyStatusLanded IdempotencyStatus = "landed"
+	// IdempotencyStatusFailed means the action was rejected and is safe
+	// to retry with a fresh nonce.
+	IdempotencyStatusFailed IdempotencyStatus = "failed"
+)
+
+// IdempotentRecord is what an IdempotencyStore persists for one action,
+// keyed by (address, nonce).
+type IdempotentRecord struct {
+	Nonce      int64
+	ActionHash string
+	Status     IdempotencyStatus
+	Response   json.RawMessage
+}
+
+// IdempotencyStore persists IdempotentRecord tuples so a retried action
+// can be recognized, rather than blindly re-POSTed under a new nonce.
+type IdempotencyStore interface {
+	Load(key string) (*IdempotentRecord, error)
+	Save(key string, record *IdempotentRecord) error
+}
+
+// MemoryIdempotencyStore is the default in-memory IdempotencyStore.
+// Records do not survive process restarts.
+type MemoryIdempotencyStore struct {
+	mu      sync.Mutex
+	records map[string]*IdempotentRecord
+}
+
+// NewMemoryIdempotencyStore creates an empty in-memory IdempotencyStore.
+func NewMemoryIdempotencyStore() *MemoryIdempotencyStore {
+	return &MemoryIdempotencyStore{records: make(map[string]*IdempotentRecord)}
+}
+
+func (s *MemoryIdempotencyStore) Load(key string) (*IdempotentRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, ok := s.records[key]
+	if !ok {
+		return nil, nil
+	}
+	copied := *rec
+	return &copied, nil
+}
+
+func (s *MemoryIdempotencyStore) Save(key string, record *IdempotentRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	copied := *record
+	s.records[key] = &copied
+	return nil
+}
+
+// RedisIdempotencyStoreClient is the minimal Redis surface
+// RedisIdempotencyStore needs. It is satisfied by e.g.
+// github.com/redis/go-redis/v9's *redis.Client, without this package
+// taking a direct dependency on any Redis driver.
+type RedisIdempotencyStoreClient interface {
+	Get(key string) (string, error)
+	Set(key, value string) error
+}
+
+// RedisIdempotencyStore is a stub IdempotencyStore for deployments that
+// share idempotency state across multiple processes. Wire in a
+// RedisIdempotencyStoreClient backed by a real Redis driver to use it in
+// production. This package ships no BoltDB-backed store; one would follow
+// the same Load/Save shape.
+type RedisIdempotencyStore struct {
+	client    RedisIdempotencyStoreClient
+	keyPrefix string
+}
+
+// NewRedisIdempotencyStore creates a RedisIdempotencyStore that namespaces
+// keys under keyPrefix (e.g. "hyperliquid:idempotency:").
+func NewRedisIdempotencyStore(client RedisIdempotencyStoreClient, keyPrefix string) *RedisIdempotencyStore {
+	return &RedisIdempotencyStore{client: client, keyPrefix: keyPrefix}
+}
+
+func (s *RedisIdempotencyStore) Load(key string) (*IdempotentRecord, error) {
+	val, err := s.client.Get(s.keyPrefix + key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load idempotency record from redis: %w", err)
+	}
+	if val == "" {
+		return nil, nil
+	}
+	var rec IdempotentRecord
+	if err := json.Unmarshal([]byte(val), &rec); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal idempotency record from redis: %w", err)
+	}
+	return &rec, nil
+}
+
+func (s *RedisIdempotencyStore) Save(key string, record *IdempotentRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal idempotency record: %w", err)
+	}
+	if err := s.client.Set(s.keyPrefix+key, string(data)); err != nil {
+		return fmt.Errorf("failed to save idempotency record to redis: %w", err)
+	}
+	return nil
+}
+
+// ActionOutcome is the result of reconciling an ambiguous POST (timeout or
+// transient 5xx) against ground truth.
+type ActionOutcome int
+
+const (
+	// ActionOutcomeUnknown means the checker could not determine whether
+	// the action landed.
+	ActionOutcomeUnknown ActionOutcome = iota
+	// ActionOutcomeLanded means the action is confirmed to have landed.
+	ActionOutcomeLanded
+	// ActionOutcomeNotLanded means the action is confirmed to not have
+	// landed, so retrying under the same nonce is safe.
+	ActionOutcomeNotLanded
+)
+
+// ActionStatusChecker reconciles an ambiguous POST against ground truth so
+// WithIdempotency knows whether it is safe to retry with the same nonce.
+// Hyperliquid's public info API has no direct "fetch action by nonce"
+// endpoint, so the default checker always returns ActionOutcomeUnknown;
+// wire in one of your own (e.g. polling UserFillsByTime or diffing a
+// ledger around the nonce's timestamp, since transfer actions embed their
+// nonce as the action's own Time field) via IdempotencyPolicy.StatusChecker
+// for true ambiguity resolution.
+type ActionStatusChecker interface {
+	Check(ctx context.Context, address string, nonce int64, actionHash string) (ActionOutcome, error)
+}
+
+type noopStatusChecker struct{}
+
+func (noopStatusChecker) Check(context.Context, string, int64, string) (ActionOutcome, error) {
+	return ActionOutcomeUnknown, nil
+}
+
+// IdempotencyPolicy configures Exchange.WithIdempotency.
+type IdempotencyPolicy struct {
+	// Store persists (nonce, action-hash, status) tuples across retries
+	// and process restarts. A nil Store defaults to an in-memory
+	// MemoryIdempotencyStore.
+	Store IdempotencyStore
+	// StatusChecker reconciles ambiguous failures against ground truth.
+	// A nil StatusChecker always reports ActionOutcomeUnknown.
+	StatusChecker ActionStatusChecker
+	// MaxRetries bounds how many times an ambiguous failure is retried
+	// (with the same nonce and signed body) before giving up.
+	MaxRetries int
+	// RetryBackoff is the delay between retries.
+	RetryBackoff time.Duration
+}
+
+func (p IdempotencyPolicy) storeOrDefault() IdempotencyStore {
+	if p.Store != nil {
+		return p.Store
+	}
+	return NewMemoryIdempotencyStore()
+}
+
+func (p IdempotencyPolicy) checkerOrDefault() ActionStatusChecker {
+	if p.StatusChecker != nil {
+		return p.StatusChecker
+	}
+	return noopStatusChecker{}
+}
+
+// WithIdempotency returns a copy of e whose transfer/vault/delegate
+// actions (UsdTransfer, WithdrawFromBridge, VaultUsdTransfer,
+// TokenDelegate) persist their (nonce, action-hash, status) to policy's
+// IdempotencyStore before POSTing, replay the exact signed body on a
+// retried call instead of re-signing under a new nonce, and consult
+// policy's ActionStatusChecker on ambiguous failures (timeout, transient
+// 5xx) before deciding whether retrying is safe.
+func (e *Exchange) WithIdempotency(policy IdempotencyPolicy) *Exchange {
+	wrapped := *e
+	wrapped.idempotencyStore = policy.storeOrDefault()
+	wrapped.idempotencyChecker = policy.checkerOrDefault()
+	wrapped.idempotencyMaxRetries = policy.MaxRetries
+	wrapped.idempotencyBackoff = policy.RetryBackoff
+	return &wrapped
+}
+
+// idempotencyKey identifies one action for the IdempotencyStore.
+func idempotencyKey(address string, nonce int64) string {
+	return fmt.Sprintf("%s:%d", address, nonce)
+}
+
+// actionHashHex is a stable hex digest of action, used to detect whether a
+// retried call is replaying the exact same action rather than colliding
+// with an unrelated one under a reused nonce.
+func actionHashHex(action any) (string, error) {
+	data, err := canonicalMarshal(action)
+	if err != nil {
+		return "", fmt.Errorf("hash action: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// postActionIdempotent posts action the same way postAction does, but —
+// when e was produced by WithIdempotency — persists its outcome and
+// retries ambiguous failures under the same nonce and signature instead of
+// letting the caller generate a fresh nonce and risk double-spending.
+func (e *Exchange) postActionIdempotent(
+	ctx context.Context,
+	action any,
+	sig SignatureResult,
+	nonce int64,
+) ([]byte, error) {
+	if e.idempotencyStore == nil {
+		return e.postAction(ctx, action, sig, nonce)
+	}
+
+	address := e.signerOrDefault().Address().Hex()
+	key := idempotencyKey(address, nonce)
+
+	hash, err := actionHashHex(action)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing, err := e.idempotencyStore.Load(key); err == nil && existing != nil {
+		if existing.ActionHash != hash {
+			return nil, fmt.Errorf(
+				"nonce %d was already used for a different action; refusing to replay or resend", nonce,
+			)
+		}
+		if existing.Status == IdempotencyStatusLanded {
+			return existing.Response, nil
+		}
+	}
+
+	if err := e.idempotencyStore.Save(key, &IdempotentRecord{
+		Nonce:      nonce,
+		ActionHash: hash,
+		Status:     IdempotencyStatusPending,
+	}); err != nil {
+		return nil, fmt.Errorf("persist pending idempotency record: %w", err)
+	}
+
+	attempts := e.idempotencyMaxRetries + 1
+	var lastErr error
+
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(e.idempotencyBackoff):
+			}
+		}
+
+		resp, err := e.postAction(ctx, action, sig, nonce)
+		if err == nil {
+			_ = e.idempotencyStore.Save(key, &IdempotentRecord{
+				Nonce:      nonce,
+				ActionHash: hash,
+				Status:     IdempotencyStatusLanded,
+				Response:   resp,
+			})
+			return resp, nil
+		}
+		lastErr = err
+
+		outcome, checkErr := e.idempotencyChecker.Check(ctx, address, nonce, hash)
+		if checkErr == nil {
+			switch outcome {
+			case ActionOutcomeLanded:
+				// Confirmed landed by the status checker, but the POST
+				// response body that described it was lost to the
+				// original timeout/error. Synthesize a minimal success
+				// body rather than surfacing an error for an action that
+				// actually succeeded.
+				landed := []byte(`{"status":"ok"}`)
+				_ = e.idempotencyStore.Save(key, &IdempotentRecord{
+					Nonce: nonce, ActionHash: hash, Status: IdempotencyStatusLanded, Response: landed,
+				})
+				return landed, nil
+			case ActionOutcomeNotLanded:
+				continue
+			}
+		}
+		// Outcome unknown: keep the record Pending and fall through to
+		// the backoff/retry above rather than guessing.
+	}
+
+	_ = e.idempotencyStore.Save(key, &IdempotentRecord{
+		Nonce: nonce, ActionHash: hash, Status: IdempotencyStatusFailed,
+	})
+	return nil, fmt.Errorf("action did not land after %d attempt(s): %w", attempts, lastErr)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sync"
 )
 
 const (
@@ -12,11 +13,91 @@ const (
 )
 
 type Info struct {
-	debug          bool
-	client         *Client
-	coinToAsset    map[string]int
-	nameToCoin     map[string]string
-	assetToDecimal map[int]int
+	debug  bool
+	client *Client
+
+	// assets holds the coinToAsset/nameToCoin/assetToDecimal/
+	// tokenIndexToName maps built at construction from Meta/SpotMeta,
+	// and hot-swapped by the background refresher configured via
+	// WithMetaRefresh; see info_refresh.go.
+	assets *assetIndex
+
+	// refreshConfig, set via WithMetaRefresh, configures the background
+	// asset-index refresher; refreshCancel stops it once started.
+	refreshConfig MetaRefreshConfig
+	refreshCancel context.CancelFunc
+
+	subscribersMu sync.Mutex
+	subscribers   []func(MetaDiff)
+
+	// metaCache, if attached via WithMetaCache, memoizes the meta-shaped
+	// endpoints (Meta, SpotMeta, MetaAndAssetCtxs, SpotMetaAndAssetCtxs,
+	// PerpDexs); see meta_cache.go.
+	metaCache *MetaCache
+
+	// requestMethod and requestMethodFallback, set via WithRequestMethod,
+	// configure the underlying client's POST/GET-fallback behavior; see
+	// client.go's RequestMethod.
+	requestMethod         RequestMethod
+	requestMethodFallback []int
+
+	// metrics, set via WithMetrics, receives RPC and builder-dex gauge
+	// instrumentation; see metrics.go. Defaults to a no-op sink.
+	metrics MetricsSink
+
+	// coalesce, set via WithRequestCoalescing, deduplicates concurrent
+	// identical requests onto one HTTP round trip; see
+	// info_coalesce.go. Nil means every call issues its own request.
+	coalesce *requestCoalescer
+
+	// recordDir, set via WithRecorder, makes post persist every call's
+	// (payload, response) pair as a fixture under this directory; see
+	// info_fixture.go. Empty means recording is disabled.
+	recordDir string
+	// replayDir, set via WithReplay, makes post short out the live
+	// client entirely and return the matching fixture recorded under
+	// this directory instead, failing on a cache miss. Empty means
+	// replay is disabled and every call goes out over the network (or
+	// through coalesce) as usual.
+	replayDir string
+}
+
+// post issues payload to path through i.client, deduplicating it against
+// any identical in-flight request when WithRequestCoalescing is attached.
+// Every *WithContext method in this file goes through post instead of
+// calling i.client.post directly, so attaching coalescing, recording, or
+// replay covers the whole Info surface at once. When WithReplay is
+// attached, post never touches the network at all - it returns whatever
+// replayFixture finds for path+payload. Otherwise, when WithRecorder is
+// attached, post persists the live call's result as a fixture before
+// returning it.
+func (i *Info) post(ctx context.Context, path string, payload any) ([]byte, error) {
+	if i.replayDir != "" {
+		return i.replayFixture(path, payload)
+	}
+
+	resp, err := i.postLive(ctx, path, payload)
+
+	if i.recordDir != "" {
+		i.recordFixture(path, payload, resp, err)
+	}
+
+	return resp, err
+}
+
+func (i *Info) postLive(ctx context.Context, path string, payload any) ([]byte, error) {
+	if i.coalesce == nil {
+		return i.client.post(ctx, path, payload)
+	}
+
+	key, err := coalesceKey(path, payload)
+	if err != nil {
+		return i.client.post(ctx, path, payload)
+	}
+
+	return i.coalesce.do(ctx, key, func(fetchCtx context.Context) ([]byte, error) {
+		return i.client.post(fetchCtx, path, payload)
+	})
 }
 
 // postTimeRangeRequest makes a POST request with time range parameters
@@ -41,62 +122,106 @@ func (i *Info) postTimeRangeRequest(
 		payload[k] = v
 	}
 
-	resp, err := i.client.post(ctx, "/info", payload)
+	resp, err := i.post(ctx, "/info", payload)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch %s: %w", requestType, err)
 	}
 	return resp, nil
 }
 
-func NewInfo(baseURL string, skipWS bool, meta *Meta, spotMeta *SpotMeta, opts ...InfoOpt) *Info {
-	info := &Info{
-		coinToAsset:    make(map[string]int),
-		nameToCoin:     make(map[string]string),
-		assetToDecimal: make(map[int]int),
-	}
-
-	for _, opt := range opts {
-		opt.Apply(info)
-	}
-
-	var clientOpts []ClientOpt
-	if info.debug {
-		clientOpts = append(clientOpts, ClientOptDebugMode())
-	}
-
-	info.client = NewClient(baseURL, clientOpts...)
+// NewInfoWithContext is NewInfo with an explicit ctx for the initial
+// Meta/SpotMeta bootstrap fetch (when meta/spotMeta are nil) and an error
+// return instead of a panic, so a transient bootstrap failure doesn't
+// crash a long-running process that could otherwise just retry. When
+// opts includes WithMetaRefresh, the returned Info also starts a
+// background goroutine that keeps the asset index hot-reloaded; stop it
+// with StopMetaRefresh.
+func NewInfoWithContext(ctx context.Context, baseURL string, skipWS bool, meta *Meta, spotMeta *SpotMeta, opts ...InfoOpt) (*Info, error) {
+	info := newInfoClient(baseURL, opts...)
 
 	if meta == nil {
 		var err error
-		meta, err = info.Meta()
+		meta, err = info.MetaWithContext(ctx)
 		if err != nil {
-			panic(err)
+			return nil, fmt.Errorf("failed to fetch meta: %w", err)
 		}
 	}
 
 	if spotMeta == nil {
 		var err error
-		spotMeta, err = info.SpotMeta()
+		spotMeta, err = info.SpotMetaWithContext(ctx)
 		if err != nil {
-			panic(err)
+			return nil, fmt.Errorf("failed to fetch spot meta: %w", err)
 		}
 	}
 
-	// Map perp assets
-	for asset, assetInfo := range meta.Universe {
-		info.coinToAsset[assetInfo.Name] = asset
-		info.nameToCoin[assetInfo.Name] = assetInfo.Name
-		info.assetToDecimal[asset] = assetInfo.SzDecimals
+	info.assets = buildAssetIndex(meta, spotMeta)
+	info.startMetaRefresh()
+
+	return info, nil
+}
+
+// NewInfo is NewInfoWithContext with ctx fixed to context.Background(),
+// kept for callers written before NewInfoWithContext existed. It panics
+// on a bootstrap failure rather than returning an error, matching its
+// original behavior.
+func NewInfo(baseURL string, skipWS bool, meta *Meta, spotMeta *SpotMeta, opts ...InfoOpt) *Info {
+	info, err := NewInfoWithContext(context.Background(), baseURL, skipWS, meta, spotMeta, opts...)
+	if err != nil {
+		panic(err)
 	}
+	return info
+}
 
-	// Map spot assets starting at 10000
-	for _, spotInfo := range spotMeta.Universe {
-		asset := spotInfo.Index + spotAssetIndexOffset
-		info.coinToAsset[spotInfo.Name] = asset
-		info.nameToCoin[spotInfo.Name] = spotInfo.Name
-		info.assetToDecimal[asset] = spotMeta.Tokens[spotInfo.Tokens[0]].SzDecimals
+// NewInfoForDex is NewInfoWithContext scoped to a single builder-deployed
+// perp dex: its asset index is built from dex's own MetaAndAssetCtxs
+// (coinToAsset/assetToDecimal differ per dex, since each dex has its own
+// asset universe) rather than the default dex's Meta, while SpotMeta is
+// still fetched from the default dex, since spot markets aren't
+// per-perp-dex. Pass "" for dex to get the default dex's universe, the
+// same as NewInfoWithContext(ctx, baseURL, skipWS, nil, nil, opts...).
+func NewInfoForDex(ctx context.Context, baseURL string, skipWS bool, dex string, opts ...InfoOpt) (*Info, error) {
+	info := newInfoClient(baseURL, opts...)
+
+	mac, err := info.MetaAndAssetCtxsWithContext(ctx, WithDex(dex))
+	if err != nil {
+		return nil, fmt.Errorf("new info for dex %q: fetch meta: %w", dex, err)
 	}
 
+	spotMeta, err := info.SpotMetaWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("new info for dex %q: fetch spot meta: %w", dex, err)
+	}
+
+	info.assets = buildAssetIndex(&mac.Meta, spotMeta)
+	info.startMetaRefresh()
+
+	return info, nil
+}
+
+// newInfoClient applies opts to a fresh Info and builds its underlying
+// client, the bootstrap both NewInfoWithContext and NewInfoForDex share
+// before they diverge on which Meta they build the asset index from.
+func newInfoClient(baseURL string, opts ...InfoOpt) *Info {
+	info := &Info{}
+
+	for _, opt := range opts {
+		opt.Apply(info)
+	}
+
+	if info.metrics == nil {
+		info.metrics = noopMetricsSink{}
+	}
+
+	var clientOpts []ClientOpt
+	if info.debug {
+		clientOpts = append(clientOpts, ClientOptDebugMode())
+	}
+	clientOpts = append(clientOpts, ClientOptRequestMethod(info.requestMethod, info.requestMethodFallback...))
+	clientOpts = append(clientOpts, ClientOptMetrics(info.metrics))
+
+	info.client = NewClient(baseURL, clientOpts...)
+
 	return info
 }
 
@@ -152,14 +277,38 @@ func parseMetaResponse(resp []byte) (*Meta, error) {
 	}, nil
 }
 
-func (i *Info) Meta() (*Meta, error) {
-	return i.MetaWithContext(context.Background())
+func (i *Info) Meta(opts ...InfoCallOpt) (*Meta, error) {
+	return i.MetaWithContext(context.Background(), opts...)
 }
 
-func (i *Info) MetaWithContext(ctx context.Context) (*Meta, error) {
-	resp, err := i.client.post(ctx, "/info", map[string]any{
+// MetaWithContext fetches the default dex's Meta, or, with WithDex(dex),
+// a builder-deployed dex's own Meta. A WithDex call bypasses metaCache,
+// since metaCache only memoizes the default dex's Meta.
+func (i *Info) MetaWithContext(ctx context.Context, opts ...InfoCallOpt) (*Meta, error) {
+	dex := resolveInfoCallOpts(opts...).dex
+	if dex != "" {
+		return i.fetchMetaWithContext(ctx, dex)
+	}
+
+	if i.metaCache != nil {
+		v, err := i.metaCache.get(ctx, metaCacheKeyMeta, func() (any, error) { return i.fetchMetaWithContext(ctx, "") })
+		if v == nil {
+			return nil, err
+		}
+		return v.(*Meta), err
+	}
+	return i.fetchMetaWithContext(ctx, "")
+}
+
+func (i *Info) fetchMetaWithContext(ctx context.Context, dex string) (*Meta, error) {
+	payload := map[string]any{
 		"type": "meta",
-	})
+	}
+	if dex != "" {
+		payload["dex"] = dex
+	}
+
+	resp, err := i.post(ctx, "/info", payload)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch meta: %w", err)
 	}
@@ -172,7 +321,18 @@ func (i *Info) SpotMeta() (*SpotMeta, error) {
 }
 
 func (i *Info) SpotMetaWithContext(ctx context.Context) (*SpotMeta, error) {
-	resp, err := i.client.post(ctx, "/info", map[string]any{
+	if i.metaCache != nil {
+		v, err := i.metaCache.get(ctx, metaCacheKeySpotMeta, func() (any, error) { return i.fetchSpotMetaWithContext(ctx) })
+		if v == nil {
+			return nil, err
+		}
+		return v.(*SpotMeta), err
+	}
+	return i.fetchSpotMetaWithContext(ctx)
+}
+
+func (i *Info) fetchSpotMetaWithContext(ctx context.Context) (*SpotMeta, error) {
+	resp, err := i.post(ctx, "/info", map[string]any{
 		"type": "spotMeta",
 	})
 	if err != nil {
@@ -188,19 +348,26 @@ func (i *Info) SpotMetaWithContext(ctx context.Context) (*SpotMeta, error) {
 }
 
 func (i *Info) NameToAsset(name string) int {
-	coin := i.nameToCoin[name]
-	return i.coinToAsset[coin]
+	coin, _ := i.assets.nameToCoinGet(name)
+	asset, _ := i.assets.coinToAssetGet(coin)
+	return asset
 }
 
-func (i *Info) UserState(address string) (*UserState, error) {
-	return i.UserStateWithContext(context.Background(), address)
+func (i *Info) UserState(address string, opts ...InfoCallOpt) (*UserState, error) {
+	return i.UserStateWithContext(context.Background(), address, opts...)
 }
 
-func (i *Info) UserStateWithContext(ctx context.Context, address string) (*UserState, error) {
-	resp, err := i.client.post(ctx, "/info", map[string]any{
+// UserStateWithContext fetches address's margin summary and positions on
+// the default dex, or, with WithDex(dex), on a builder-deployed dex.
+func (i *Info) UserStateWithContext(ctx context.Context, address string, opts ...InfoCallOpt) (*UserState, error) {
+	payload := map[string]any{
 		"type": "clearinghouseState",
 		"user": address,
-	})
+	}
+	if dex := resolveInfoCallOpts(opts...).dex; dex != "" {
+		payload["dex"] = dex
+	}
+	resp, err := i.post(ctx, "/info", payload)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch user state: %w", err)
 	}
@@ -217,7 +384,7 @@ func (i *Info) SpotUserState(address string) (*SpotUserState, error) {
 }
 
 func (i *Info) SpotUserStateWithContext(ctx context.Context, address string) (*SpotUserState, error) {
-	resp, err := i.client.post(ctx, "/info", map[string]any{
+	resp, err := i.post(ctx, "/info", map[string]any{
 		"type": "spotClearinghouseState",
 		"user": address,
 	})
@@ -232,15 +399,21 @@ func (i *Info) SpotUserStateWithContext(ctx context.Context, address string) (*S
 	return &result, nil
 }
 
-func (i *Info) OpenOrders(address string) ([]OpenOrder, error) {
-	return i.OpenOrdersWithContext(context.Background(), address)
+func (i *Info) OpenOrders(address string, opts ...InfoCallOpt) ([]OpenOrder, error) {
+	return i.OpenOrdersWithContext(context.Background(), address, opts...)
 }
 
-func (i *Info) OpenOrdersWithContext(ctx context.Context, address string) ([]OpenOrder, error) {
-	resp, err := i.client.post(ctx, "/info", map[string]any{
+// OpenOrdersWithContext fetches address's open orders on the default dex,
+// or, with WithDex(dex), on a builder-deployed dex.
+func (i *Info) OpenOrdersWithContext(ctx context.Context, address string, opts ...InfoCallOpt) ([]OpenOrder, error) {
+	payload := map[string]any{
 		"type": "openOrders",
 		"user": address,
-	})
+	}
+	if dex := resolveInfoCallOpts(opts...).dex; dex != "" {
+		payload["dex"] = dex
+	}
+	resp, err := i.post(ctx, "/info", payload)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch open orders: %w", err)
 	}
@@ -257,7 +430,7 @@ func (i *Info) FrontendOpenOrders(address string) ([]OpenOrder, error) {
 }
 
 func (i *Info) FrontendOpenOrdersWithContext(ctx context.Context, address string) ([]OpenOrder, error) {
-	resp, err := i.client.post(ctx, "/info", map[string]any{
+	resp, err := i.post(ctx, "/info", map[string]any{
 		"type": "frontendOpenOrders",
 		"user": address,
 	})
@@ -277,7 +450,7 @@ func (i *Info) AllMids() (map[string]string, error) {
 }
 
 func (i *Info) AllMidsWithContext(ctx context.Context) (map[string]string, error) {
-	resp, err := i.client.post(ctx, "/info", map[string]any{
+	resp, err := i.post(ctx, "/info", map[string]any{
 		"type": "allMids",
 	})
 	if err != nil {
@@ -291,15 +464,21 @@ func (i *Info) AllMidsWithContext(ctx context.Context) (map[string]string, error
 	return result, nil
 }
 
-func (i *Info) UserFills(address string) ([]Fill, error) {
-	return i.UserFillsWithContext(context.Background(), address)
+func (i *Info) UserFills(address string, opts ...InfoCallOpt) ([]Fill, error) {
+	return i.UserFillsWithContext(context.Background(), address, opts...)
 }
 
-func (i *Info) UserFillsWithContext(ctx context.Context, address string) ([]Fill, error) {
-	resp, err := i.client.post(ctx, "/info", map[string]any{
+// UserFillsWithContext fetches address's fills on the default dex, or,
+// with WithDex(dex), on a builder-deployed dex.
+func (i *Info) UserFillsWithContext(ctx context.Context, address string, opts ...InfoCallOpt) ([]Fill, error) {
+	payload := map[string]any{
 		"type": "userFills",
 		"user": address,
-	})
+	}
+	if dex := resolveInfoCallOpts(opts...).dex; dex != "" {
+		payload["dex"] = dex
+	}
+	resp, err := i.post(ctx, "/info", payload)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch user fills: %w", err)
 	}
@@ -328,12 +507,30 @@ func (i *Info) UserFillsByTimeWithContext(ctx context.Context, address string, s
 	return result, nil
 }
 
-func (i *Info) MetaAndAssetCtxs() (*MetaAndAssetCtxs, error) {
-	return i.MetaAndAssetCtxsWithContext(context.Background())
+func (i *Info) MetaAndAssetCtxs(opts ...InfoCallOpt) (*MetaAndAssetCtxs, error) {
+	return i.MetaAndAssetCtxsWithContext(context.Background(), opts...)
+}
+
+// MetaAndAssetCtxsWithContext fetches the default dex's meta and asset
+// contexts, or, with WithDex(dex), a builder-deployed dex's own - the same
+// request MetaAndAssetCtxsForDexWithContext already served with a plain dex
+// parameter, which this delegates to when dex is set.
+func (i *Info) MetaAndAssetCtxsWithContext(ctx context.Context, opts ...InfoCallOpt) (*MetaAndAssetCtxs, error) {
+	if dex := resolveInfoCallOpts(opts...).dex; dex != "" {
+		return i.MetaAndAssetCtxsForDexWithContext(ctx, dex)
+	}
+	if i.metaCache != nil {
+		v, err := i.metaCache.get(ctx, metaCacheKeyAssetCtxs(""), func() (any, error) { return i.fetchMetaAndAssetCtxsWithContext(ctx) })
+		if v == nil {
+			return nil, err
+		}
+		return v.(*MetaAndAssetCtxs), err
+	}
+	return i.fetchMetaAndAssetCtxsWithContext(ctx)
 }
 
-func (i *Info) MetaAndAssetCtxsWithContext(ctx context.Context) (*MetaAndAssetCtxs, error) {
-	resp, err := i.client.post(ctx, "/info", map[string]any{
+func (i *Info) fetchMetaAndAssetCtxsWithContext(ctx context.Context) (*MetaAndAssetCtxs, error) {
+	resp, err := i.post(ctx, "/info", map[string]any{
 		"type": "metaAndAssetCtxs",
 	})
 	if err != nil {
@@ -377,12 +574,84 @@ func (i *Info) MetaAndAssetCtxsWithContext(ctx context.Context) (*MetaAndAssetCt
 	return metaAndAssetCtxs, nil
 }
 
+// MetaAndAssetCtxsForDex is like MetaAndAssetCtxs but scoped to a single
+// builder-deployed perp dex, as returned by PerpDexs.
+func (i *Info) MetaAndAssetCtxsForDex(dex string) (*MetaAndAssetCtxs, error) {
+	return i.MetaAndAssetCtxsForDexWithContext(context.Background(), dex)
+}
+
+func (i *Info) MetaAndAssetCtxsForDexWithContext(ctx context.Context, dex string) (*MetaAndAssetCtxs, error) {
+	if i.metaCache != nil {
+		v, err := i.metaCache.get(ctx, metaCacheKeyAssetCtxs(dex), func() (any, error) { return i.fetchMetaAndAssetCtxsForDexWithContext(ctx, dex) })
+		if v == nil {
+			return nil, err
+		}
+		return v.(*MetaAndAssetCtxs), err
+	}
+	return i.fetchMetaAndAssetCtxsForDexWithContext(ctx, dex)
+}
+
+func (i *Info) fetchMetaAndAssetCtxsForDexWithContext(ctx context.Context, dex string) (*MetaAndAssetCtxs, error) {
+	resp, err := i.post(ctx, "/info", map[string]any{
+		"type": "metaAndAssetCtxs",
+		"dex":  dex,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch meta and asset contexts for dex %q: %w", dex, err)
+	}
+
+	var result []any
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal meta and asset contexts: %w", err)
+	}
+
+	if len(result) < 2 {
+		return nil, fmt.Errorf("expected at least 2 elements in response, got %d", len(result))
+	}
+
+	metaBytes, err := json.Marshal(result[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal meta data: %w", err)
+	}
+
+	meta, err := parseMetaResponse(metaBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse meta: %w", err)
+	}
+
+	ctxsBytes, err := json.Marshal(result[1])
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal ctxs data: %w", err)
+	}
+
+	var ctxs []AssetCtx
+	if err := json.Unmarshal(ctxsBytes, &ctxs); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal ctxs: %w", err)
+	}
+
+	return &MetaAndAssetCtxs{
+		Meta: *meta,
+		Ctxs: ctxs,
+	}, nil
+}
+
 func (i *Info) SpotMetaAndAssetCtxs() (*SpotMetaAndAssetCtxs, error) {
 	return i.SpotMetaAndAssetCtxsWithContext(context.Background())
 }
 
 func (i *Info) SpotMetaAndAssetCtxsWithContext(ctx context.Context) (*SpotMetaAndAssetCtxs, error) {
-	resp, err := i.client.post(ctx, "/info", map[string]any{
+	if i.metaCache != nil {
+		v, err := i.metaCache.get(ctx, metaCacheKeySpotCtxs, func() (any, error) { return i.fetchSpotMetaAndAssetCtxsWithContext(ctx) })
+		if v == nil {
+			return nil, err
+		}
+		return v.(*SpotMetaAndAssetCtxs), err
+	}
+	return i.fetchSpotMetaAndAssetCtxsWithContext(ctx)
+}
+
+func (i *Info) fetchSpotMetaAndAssetCtxsWithContext(ctx context.Context) (*SpotMetaAndAssetCtxs, error) {
+	resp, err := i.post(ctx, "/info", map[string]any{
 		"type": "spotMetaAndAssetCtxs",
 	})
 	if err != nil {
@@ -440,7 +709,7 @@ func (i *Info) FundingHistoryWithContext(
 	startTime int64,
 	endTime *int64,
 ) ([]FundingHistory, error) {
-	coin := i.nameToCoin[name]
+	coin := i.coinFor(name)
 	resp, err := i.postTimeRangeRequest(
 		ctx,
 		"fundingHistory",
@@ -491,9 +760,9 @@ func (i *Info) L2Snapshot(name string) (*L2Book, error) {
 }
 
 func (i *Info) L2SnapshotWithContext(ctx context.Context, name string) (*L2Book, error) {
-	resp, err := i.client.post(ctx, "/info", map[string]any{
+	resp, err := i.post(ctx, "/info", map[string]any{
 		"type": "l2Book",
-		"coin": i.nameToCoin[name],
+		"coin": i.coinFor(name),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch L2 snapshot: %w", err)
@@ -512,13 +781,13 @@ func (i *Info) CandlesSnapshot(name, interval string, startTime, endTime int64)
 
 func (i *Info) CandlesSnapshotWithContext(ctx context.Context, name, interval string, startTime, endTime int64) ([]Candle, error) {
 	req := map[string]any{
-		"coin":      i.nameToCoin[name],
+		"coin":      i.coinFor(name),
 		"interval":  interval,
 		"startTime": startTime,
 		"endTime":   endTime,
 	}
 
-	resp, err := i.client.post(ctx, "/info", map[string]any{
+	resp, err := i.post(ctx, "/info", map[string]any{
 		"type": "candleSnapshot",
 		"req":  req,
 	})
@@ -538,7 +807,7 @@ func (i *Info) UserFees(address string) (*UserFees, error) {
 }
 
 func (i *Info) UserFeesWithContext(ctx context.Context, address string) (*UserFees, error) {
-	resp, err := i.client.post(ctx, "/info", map[string]any{
+	resp, err := i.post(ctx, "/info", map[string]any{
 		"type": "userFees",
 		"user": address,
 	})
@@ -553,16 +822,23 @@ func (i *Info) UserFeesWithContext(ctx context.Context, address string) (*UserFe
 	return &result, nil
 }
 
-func (i *Info) UserActiveAssetData(address string, coin string) (*UserActiveAssetData, error) {
-	return i.UserActiveAssetDataWithContext(context.Background(), address, coin)
+func (i *Info) UserActiveAssetData(address string, coin string, opts ...InfoCallOpt) (*UserActiveAssetData, error) {
+	return i.UserActiveAssetDataWithContext(context.Background(), address, coin, opts...)
 }
 
-func (i *Info) UserActiveAssetDataWithContext(ctx context.Context, address string, coin string) (*UserActiveAssetData, error) {
-	resp, err := i.client.post(ctx, "/info", map[string]any{
+// UserActiveAssetDataWithContext fetches address's leverage/margin state
+// for coin on the default dex, or, with WithDex(dex), on a builder-deployed
+// dex.
+func (i *Info) UserActiveAssetDataWithContext(ctx context.Context, address string, coin string, opts ...InfoCallOpt) (*UserActiveAssetData, error) {
+	payload := map[string]any{
 		"type": "activeAssetData",
 		"user": address,
 		"coin": coin,
-	})
+	}
+	if dex := resolveInfoCallOpts(opts...).dex; dex != "" {
+		payload["dex"] = dex
+	}
+	resp, err := i.post(ctx, "/info", payload)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch user active asset data: %w", err)
 	}
@@ -579,7 +855,7 @@ func (i *Info) UserStakingSummary(address string) (*StakingSummary, error) {
 }
 
 func (i *Info) UserStakingSummaryWithContext(ctx context.Context, address string) (*StakingSummary, error) {
-	resp, err := i.client.post(ctx, "/info", map[string]any{
+	resp, err := i.post(ctx, "/info", map[string]any{
 		"type": "delegatorSummary",
 		"user": address,
 	})
@@ -599,7 +875,7 @@ func (i *Info) UserStakingDelegations(address string) ([]StakingDelegation, erro
 }
 
 func (i *Info) UserStakingDelegationsWithContext(ctx context.Context, address string) ([]StakingDelegation, error) {
-	resp, err := i.client.post(ctx, "/info", map[string]any{
+	resp, err := i.post(ctx, "/info", map[string]any{
 		"type": "delegations",
 		"user": address,
 	})
@@ -619,7 +895,7 @@ func (i *Info) UserStakingRewards(address string) ([]StakingReward, error) {
 }
 
 func (i *Info) UserStakingRewardsWithContext(ctx context.Context, address string) ([]StakingReward, error) {
-	resp, err := i.client.post(ctx, "/info", map[string]any{
+	resp, err := i.post(ctx, "/info", map[string]any{
 		"type": "delegatorRewards",
 		"user": address,
 	})
@@ -644,7 +920,7 @@ func (i *Info) QueryOrderByOid(userAddress string, oid int64) (*OrderQueryResult
 }
 
 func (i *Info) QueryOrderByOidWithContext(ctx context.Context, userAddress string, oid int64) (*OrderQueryResult, error) {
-	resp, err := i.client.post(ctx, "/info", map[string]any{
+	resp, err := i.post(ctx, "/info", map[string]any{
 		"type": "orderStatus",
 		"user": userAddress,
 		"oid":  oid,
@@ -670,7 +946,7 @@ func (i *Info) QueryOrderByCloid(userAddress, cloid string) (*OrderQueryResult,
 }
 
 func (i *Info) QueryOrderByCloidWithContext(ctx context.Context, userAddress, cloid string) (*OrderQueryResult, error) {
-	resp, err := i.client.post(ctx, "/info", map[string]any{
+	resp, err := i.post(ctx, "/info", map[string]any{
 		"type": "orderStatus",
 		"user": userAddress,
 		"oid":  cloid,
@@ -691,7 +967,7 @@ func (i *Info) QueryReferralState(user string) (*ReferralState, error) {
 }
 
 func (i *Info) QueryReferralStateWithContext(ctx context.Context, user string) (*ReferralState, error) {
-	resp, err := i.client.post(ctx, "/info", map[string]any{
+	resp, err := i.post(ctx, "/info", map[string]any{
 		"type": "referral",
 		"user": user,
 	})
@@ -711,7 +987,7 @@ func (i *Info) QuerySubAccounts(user string) ([]SubAccount, error) {
 }
 
 func (i *Info) QuerySubAccountsWithContext(ctx context.Context, user string) ([]SubAccount, error) {
-	resp, err := i.client.post(ctx, "/info", map[string]any{
+	resp, err := i.post(ctx, "/info", map[string]any{
 		"type": "subAccounts",
 		"user": user,
 	})
@@ -731,7 +1007,7 @@ func (i *Info) QueryUserToMultiSigSigners(multiSigUser string) ([]MultiSigSigner
 }
 
 func (i *Info) QueryUserToMultiSigSignersWithContext(ctx context.Context, multiSigUser string) ([]MultiSigSigner, error) {
-	resp, err := i.client.post(ctx, "/info", map[string]any{
+	resp, err := i.post(ctx, "/info", map[string]any{
 		"type": "userToMultiSigSigners",
 		"user": multiSigUser,
 	})
@@ -752,7 +1028,18 @@ func (i *Info) PerpDexs() ([]string, error) {
 }
 
 func (i *Info) PerpDexsWithContext(ctx context.Context) ([]string, error) {
-	resp, err := i.client.post(ctx, "/info", map[string]any{
+	if i.metaCache != nil {
+		v, err := i.metaCache.get(ctx, metaCacheKeyPerpDexs, func() (any, error) { return i.fetchPerpDexsWithContext(ctx) })
+		if v == nil {
+			return nil, err
+		}
+		return v.([]string), err
+	}
+	return i.fetchPerpDexsWithContext(ctx)
+}
+
+func (i *Info) fetchPerpDexsWithContext(ctx context.Context) ([]string, error) {
+	resp, err := i.post(ctx, "/info", map[string]any{
 		"type": "perpDexs",
 	})
 	if err != nil {
@@ -765,3 +1052,128 @@ func (i *Info) PerpDexsWithContext(ctx context.Context) ([]string, error) {
 	}
 	return result, nil
 }
+
+// PerpDex is a single builder-deployed perp dex, as listed by PerpDexs
+// beyond the default (null) entry.
+type PerpDex struct {
+	Name string
+}
+
+// PerpDexLimits is a builder-deployed perp dex's configured open-interest
+// and transfer caps, as returned by Info.PerpDexLimits.
+type PerpDexLimits struct {
+	TotalOiCap     string
+	OiSzCapPerPerp string
+	MaxTransferNtl string
+}
+
+// PerpDexLimits fetches dex's configured OI/transfer caps. dex must be
+// non-empty; it identifies a builder-deployed dex the way
+// MetaAndAssetCtxsForDex does, and the default dex has no limits of its
+// own to query.
+func (i *Info) PerpDexLimits(ctx context.Context, dex string) (*PerpDexLimits, error) {
+	if dex == "" {
+		return nil, ValidationError("dex", "dex parameter is required")
+	}
+
+	resp, err := i.post(ctx, "/info", map[string]any{
+		"type": "perpDexLimits",
+		"dex":  dex,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch perp dex limits: %w", err)
+	}
+
+	var result PerpDexLimits
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal perp dex limits: %w", err)
+	}
+	i.metrics.SetPerpDexOiCap(dex, parseFloat(result.TotalOiCap))
+	return &result, nil
+}
+
+// PerpDexStatus is a builder-deployed perp dex's current deposit state, as
+// returned by Info.PerpDexStatus.
+type PerpDexStatus struct {
+	TotalNetDeposit string
+}
+
+// PerpDexStatus fetches dex's current deposit status. dex must be
+// non-empty, for the same reason as PerpDexLimits.
+func (i *Info) PerpDexStatus(ctx context.Context, dex string) (*PerpDexStatus, error) {
+	if dex == "" {
+		return nil, ValidationError("dex", "dex parameter is required")
+	}
+
+	resp, err := i.post(ctx, "/info", map[string]any{
+		"type": "perpDexStatus",
+		"dex":  dex,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch perp dex status: %w", err)
+	}
+
+	var result PerpDexStatus
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal perp dex status: %w", err)
+	}
+	i.metrics.SetPerpDexTotalNetDeposit(dex, parseFloat(result.TotalNetDeposit))
+	return &result, nil
+}
+
+// PerpDeployAuctionStatus is the state of Hyperliquid's ongoing Dutch
+// auction for deploying a new builder perp dex, as returned by
+// Info.PerpDeployAuctionStatus.
+type PerpDeployAuctionStatus struct {
+	StartTimeSeconds int64
+	DurationSeconds  int64
+	StartGas         string
+	CurrentGas       string
+}
+
+// PerpDeployAuctionStatus fetches the current perp-dex-deploy auction
+// state. Unlike PerpDexLimits/PerpDexStatus, this is venue-wide and takes
+// no dex parameter.
+func (i *Info) PerpDeployAuctionStatus(ctx context.Context) (*PerpDeployAuctionStatus, error) {
+	resp, err := i.post(ctx, "/info", map[string]any{
+		"type": "perpDeployAuctionStatus",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch perp deploy auction status: %w", err)
+	}
+
+	var result PerpDeployAuctionStatus
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal perp deploy auction status: %w", err)
+	}
+	i.metrics.SetPerpAuctionCurrentGas(parseFloat(result.CurrentGas))
+	return &result, nil
+}
+
+// InfoTwapHistory fetches every TWAP user has placed, most recent first.
+// dex selects a builder-deployed perp dex the way PerpDexLimits does;
+// pass "" for the default dex.
+func (i *Info) InfoTwapHistory(user, dex string) ([]TwapHistoryEntry, error) {
+	return i.InfoTwapHistoryWithContext(context.Background(), user, dex)
+}
+
+func (i *Info) InfoTwapHistoryWithContext(ctx context.Context, user, dex string) ([]TwapHistoryEntry, error) {
+	payload := map[string]any{
+		"type": "twapHistory",
+		"user": user,
+	}
+	if dex != "" {
+		payload["dex"] = dex
+	}
+
+	resp, err := i.post(ctx, "/info", payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch twap history: %w", err)
+	}
+
+	var result []TwapHistoryEntry
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal twap history: %w", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,103 @@
+package hyperliquid
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+)
+
+// requestCoalesceCall is one in-flight POST other callers with the same
+// coalesce key wait on instead of issuing their own request, the same
+// singleflight dedup shape MetaCache.get uses for its TTL-memoized
+// endpoints, generalized here to every Info endpoint's raw
+// request/response bytes rather than just the meta-shaped ones.
+type requestCoalesceCall struct {
+	done chan struct{}
+	resp []byte
+	err  error
+}
+
+// requestCoalescer deduplicates concurrent identical Info requests: the
+// first caller for a given key performs the POST, and every other caller
+// that arrives while it is in flight blocks on that call's result instead
+// of issuing a duplicate HTTP round trip. Attach one to an Info via
+// WithRequestCoalescing; the zero value is not usable.
+type requestCoalescer struct {
+	mu    sync.Mutex
+	calls map[string]*requestCoalesceCall
+}
+
+func newRequestCoalescer() *requestCoalescer {
+	return &requestCoalescer{calls: make(map[string]*requestCoalesceCall)}
+}
+
+// coalesceKey derives a dedup key from path and payload's canonical JSON
+// encoding (type, user, coin, time bounds, ... - whatever fields the
+// caller built the payload map from), so two calls built from the same
+// fields collide even though map iteration order isn't stable.
+func coalesceKey(path string, payload any) (string, error) {
+	canon, err := canonicalJSON(payload)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(append([]byte(path+"\x00"), canon...))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// canonicalJSON re-marshals v through a generic map so encoding/json's
+// sorted-map-key guarantee yields identical bytes regardless of v's
+// original map's iteration order.
+func canonicalJSON(v any) ([]byte, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var generic any
+	if err := json.Unmarshal(raw, &generic); err != nil {
+		return nil, err
+	}
+	return json.Marshal(generic)
+}
+
+// do executes fetch deduplicated against key: if a call for key is
+// already in flight, it waits for that call's result, or for ctx to be
+// done, whichever comes first, rather than invoking fetch itself. A
+// caller's ctx only ever unblocks that one caller's wait - fetch itself
+// always runs with a detached context (see below), so no single caller
+// giving up, whether it registered the call or merely joined one already
+// in flight, tears down the request for every other caller still waiting
+// on it.
+func (rc *requestCoalescer) do(ctx context.Context, key string, fetch func(context.Context) ([]byte, error)) ([]byte, error) {
+	rc.mu.Lock()
+	if call, ok := rc.calls[key]; ok {
+		rc.mu.Unlock()
+		select {
+		case <-call.done:
+			return call.resp, call.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	call := &requestCoalesceCall{done: make(chan struct{})}
+	rc.calls[key] = call
+	rc.mu.Unlock()
+
+	// fetch runs with context.Background() rather than the registering
+	// caller's ctx: that caller is otherwise indistinguishable from any
+	// other waiter on this key, and its context cancelling (a request
+	// timeout, that caller giving up) must not tear down the shared call
+	// every other waiter is blocked on.
+	resp, err := fetch(context.Background())
+
+	rc.mu.Lock()
+	delete(rc.calls, key)
+	rc.mu.Unlock()
+
+	call.resp, call.err = resp, err
+	close(call.done)
+
+	return resp, err
+}
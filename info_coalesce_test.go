@@ -0,0 +1,156 @@
+package hyperliquid
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestCoalescerDoDeduplicatesConcurrentCallers(t *testing.T) {
+	rc := newRequestCoalescer()
+
+	var calls atomic.Int64
+	release := make(chan struct{})
+	fetch := func(context.Context) ([]byte, error) {
+		calls.Add(1)
+		<-release
+		return []byte("value"), nil
+	}
+
+	var wg sync.WaitGroup
+	for n := 0; n < 5; n++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := rc.do(context.Background(), "k", fetch)
+			assert.NoError(t, err)
+			assert.Equal(t, []byte("value"), resp)
+		}()
+	}
+
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int64(1), calls.Load())
+}
+
+func TestRequestCoalescerDoIssuesSeparateRequestsForDifferentKeys(t *testing.T) {
+	rc := newRequestCoalescer()
+
+	var calls atomic.Int64
+	fetch := func(context.Context) ([]byte, error) {
+		calls.Add(1)
+		return []byte("value"), nil
+	}
+
+	_, err := rc.do(context.Background(), "a", fetch)
+	require.NoError(t, err)
+	_, err = rc.do(context.Background(), "b", fetch)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(2), calls.Load())
+}
+
+func TestRequestCoalescerDoCancelledFollowerDoesNotCancelLeader(t *testing.T) {
+	rc := newRequestCoalescer()
+
+	leaderDone := make(chan struct{})
+	fetch := func(context.Context) ([]byte, error) {
+		<-leaderDone
+		return []byte("value"), nil
+	}
+
+	go func() {
+		_, _ = rc.do(context.Background(), "k", fetch)
+	}()
+	time.Sleep(10 * time.Millisecond) // let the leader register its call
+
+	followerCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := rc.do(followerCtx, "k", fetch)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	close(leaderDone)
+}
+
+// TestRequestCoalescerDoCancelledLeaderDoesNotCancelFetch guards against the
+// bug chunk10-1's review flagged: fetch used to run with whichever caller's
+// ctx happened to register the call first, so that caller's own context
+// cancelling (a request timeout, that caller giving up) tore down the
+// shared fetch for every other waiter on the same key, not just its own.
+// fetch here mimics a context-respecting HTTP client (http.NewRequestWithContext)
+// by returning ctx.Err() as soon as its ctx is cancelled, so the old,
+// leader-ctx-wired code would observe the cancellation and abort; the fix
+// (fetch always runs with context.Background()) must not.
+// TestRequestCoalescerDoCancelledFollowerDoesNotCancelLeader alone can't
+// catch this: it gives the leader context.Background(), which can never be
+// cancelled.
+func TestRequestCoalescerDoCancelledLeaderDoesNotCancelFetch(t *testing.T) {
+	rc := newRequestCoalescer()
+
+	fetchStarted := make(chan struct{})
+	release := make(chan struct{})
+	fetch := func(fetchCtx context.Context) ([]byte, error) {
+		close(fetchStarted)
+		select {
+		case <-fetchCtx.Done():
+			return nil, fetchCtx.Err()
+		case <-release:
+			return []byte("value"), nil
+		}
+	}
+
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	leaderResp := make(chan []byte, 1)
+	go func() {
+		resp, err := rc.do(leaderCtx, "k", fetch)
+		leaderResp <- resp
+		leaderErr <- err
+	}()
+	<-fetchStarted
+
+	cancelLeader()
+
+	siblingDone := make(chan struct{})
+	var siblingResp []byte
+	var siblingErr error
+	go func() {
+		siblingResp, siblingErr = rc.do(context.Background(), "k", fetch)
+		close(siblingDone)
+	}()
+	time.Sleep(10 * time.Millisecond) // let the sibling join the in-flight call
+
+	close(release)
+
+	<-siblingDone
+	assert.NoError(t, siblingErr)
+	assert.Equal(t, []byte("value"), siblingResp)
+
+	assert.NoError(t, <-leaderErr)
+	assert.Equal(t, []byte("value"), <-leaderResp)
+}
+
+func TestCoalesceKeyIsStableAcrossMapIterationOrder(t *testing.T) {
+	a := map[string]any{"type": "l2Book", "coin": "BTC", "user": "0x1"}
+	b := map[string]any{"user": "0x1", "coin": "BTC", "type": "l2Book"}
+
+	keyA, err := coalesceKey("/info", a)
+	require.NoError(t, err)
+	keyB, err := coalesceKey("/info", b)
+	require.NoError(t, err)
+
+	assert.Equal(t, keyA, keyB)
+}
+
+func TestWithRequestCoalescingAttachesCoalescer(t *testing.T) {
+	info := &Info{}
+	WithRequestCoalescing().Apply(info)
+
+	assert.NotNil(t, info.coalesce)
+}
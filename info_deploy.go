@@ -0,0 +1,59 @@
+package hyperliquid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// SpotDeployState is the in-progress spot deployment state for a deployer,
+// used by Exchange.WaitForAction's confirmers to detect when a
+// SpotDeployGenesis or SpotDeployFreezeUser action has actually landed. The
+// exact field set is this module's best-effort approximation of the wire
+// schema; adjust it if Hyperliquid's response differs.
+type SpotDeployState struct {
+	Deployer    string   `json:"deployer"`
+	DexName     string   `json:"dexName,omitempty"`
+	GenesisDone bool     `json:"genesisDone"`
+	FrozenUsers []string `json:"frozenUsers"`
+}
+
+// SpotDeployStateWithContext fetches the in-progress spot deployment state
+// for deployer.
+func (i *Info) SpotDeployStateWithContext(ctx context.Context, deployer string) (*SpotDeployState, error) {
+	resp, err := i.post(ctx, "/info", map[string]any{
+		"type": "spotDeployState",
+		"user": deployer,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch spot deploy state: %w", err)
+	}
+
+	var result SpotDeployState
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal spot deploy state: %w", err)
+	}
+	return &result, nil
+}
+
+// ValidatorSummary is one entry in the active consensus validator set.
+type ValidatorSummary struct {
+	Validator string `json:"validator"`
+	IsJailed  bool   `json:"isJailed"`
+}
+
+// ValidatorSummariesWithContext fetches the current active validator set.
+func (i *Info) ValidatorSummariesWithContext(ctx context.Context) ([]ValidatorSummary, error) {
+	resp, err := i.post(ctx, "/info", map[string]any{
+		"type": "validatorSummaries",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch validator summaries: %w", err)
+	}
+
+	var result []ValidatorSummary
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal validator summaries: %w", err)
+	}
+	return result, nil
+}
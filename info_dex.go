@@ -0,0 +1,73 @@
+package hyperliquid
+
+import (
+	"context"
+	"fmt"
+)
+
+// InfoCallOpt configures a single Info call, as opposed to InfoOpt which
+// configures the Info instance itself. The only InfoCallOpt today is
+// WithDex; more can be added the same way without changing any
+// *WithContext method's existing call sites, since it's accepted as a
+// trailing variadic.
+type InfoCallOpt func(*infoCallOpts)
+
+// infoCallOpts is the resolved state every InfoCallOpt mutates. The zero
+// value (no opts passed) scopes a call to the default dex, matching every
+// *WithContext method's behavior before InfoCallOpt existed.
+type infoCallOpts struct {
+	dex string
+}
+
+// resolveInfoCallOpts applies opts in order and returns the result, for
+// *WithContext methods that accept a ...InfoCallOpt tail.
+func resolveInfoCallOpts(opts ...InfoCallOpt) infoCallOpts {
+	var o infoCallOpts
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// WithDex scopes an Info call to a builder-deployed perp dex by name, the
+// same dex value PerpDexs lists and MetaAndAssetCtxsForDex/PerpDexLimits/
+// PerpDexStatus already took as a plain string parameter. Omitting WithDex
+// (or passing "") scopes the call to the default dex.
+func WithDex(dex string) InfoCallOpt {
+	return func(o *infoCallOpts) {
+		o.dex = dex
+	}
+}
+
+// ListPerpDexMeta fetches every known perp dex's Meta in one shot: the
+// default dex plus every dex PerpDexsWithContext lists, keyed by dex name
+// ("" for the default dex). Each dex's universe and asset indices are
+// independent, so this is the convenient way to look up an asset on a
+// dex without first constructing a dex-scoped Info via NewInfoForDex.
+func (i *Info) ListPerpDexMeta(ctx context.Context) (map[string]*Meta, error) {
+	names, err := i.PerpDexsWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list perp dex meta: fetch dex list: %w", err)
+	}
+
+	result := make(map[string]*Meta, len(names)+1)
+
+	defaultMeta, err := i.MetaWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list perp dex meta: fetch default meta: %w", err)
+	}
+	result[""] = defaultMeta
+
+	for _, name := range names {
+		if name == "" {
+			continue
+		}
+		meta, err := i.MetaWithContext(ctx, WithDex(name))
+		if err != nil {
+			return nil, fmt.Errorf("list perp dex meta: fetch meta for dex %q: %w", name, err)
+		}
+		result[name] = meta
+	}
+
+	return result, nil
+}
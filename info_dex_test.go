@@ -0,0 +1,44 @@
+package hyperliquid
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveInfoCallOptsDefaultsToEmptyDex(t *testing.T) {
+	opts := resolveInfoCallOpts()
+	assert.Equal(t, "", opts.dex)
+}
+
+func TestWithDexSetsDex(t *testing.T) {
+	opts := resolveInfoCallOpts(WithDex("test"))
+	assert.Equal(t, "test", opts.dex)
+}
+
+func TestWithDexLastOptWins(t *testing.T) {
+	opts := resolveInfoCallOpts(WithDex("test"), WithDex("builder2"))
+	assert.Equal(t, "builder2", opts.dex)
+}
+
+func TestReplayFixtureMetaWithDexFetchesDexScopedUniverse(t *testing.T) {
+	info := testReplayInfo(t)
+
+	meta, err := info.MetaWithContext(context.Background(), WithDex("test"))
+
+	require.NoError(t, err)
+	require.Len(t, meta.Universe, 1)
+	assert.Equal(t, "TSLA", meta.Universe[0].Name)
+}
+
+func TestReplayFixtureMetaWithoutDexStillFetchesDefaultUniverse(t *testing.T) {
+	info := testReplayInfo(t)
+
+	meta, err := info.MetaWithContext(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, meta.Universe, 2)
+	assert.Equal(t, "BTC", meta.Universe[0].Name)
+}
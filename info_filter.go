@@ -0,0 +1,167 @@
+package hyperliquid
+
+import (
+	"context"
+	"strconv"
+)
+
+// TokenID identifies an asset by both its coin/pair symbol and its
+// canonical numeric asset index (the same index CoinToAsset/tickLotDecimals
+// use internally), so two different spellings of the same asset (the coin
+// symbol "HYPE" and its token index 1105) can be compared or displayed
+// consistently. WeiDecimals, SzDecimals, and IsCanonical mirror the spot
+// token metadata Hyperliquid's spotMeta endpoint reports (a spot pair's
+// quote must be a canonical stable token); they are zero-value for
+// TokenIDs built from Fill/SpotBalance data, which carry no decimals or
+// canonical flag of their own. See AssetPair for a base/quote pair built
+// from two TokenIDs, and Validate for the checks both enforce.
+type TokenID struct {
+	Name        string
+	Index       int
+	WeiDecimals int
+	SzDecimals  int
+	IsCanonical bool
+}
+
+// Filter restricts UserFillsFiltered, UserFillsByTimeFiltered, and
+// SpotUserStateFiltered to a set of token identities. Each entry in Tokens
+// may be a perp coin symbol ("ETH"), a spot pair name ("PURR/USDC"), or a
+// numeric token index as a decimal string ("1105" for HYPE); resolveFilter
+// normalizes every form to the same canonical coin name via the cached
+// Meta/SpotMeta tables, so filtering by "HYPE" and filtering by "1105"
+// return identical results. A zero-value Filter matches everything.
+type Filter struct {
+	Tokens []string
+}
+
+// FilteredFills is UserFillsFiltered/UserFillsByTimeFiltered's result: the
+// fills that matched the Filter, plus the distinct token identities found
+// among them so a caller can render a filter UI without re-deriving each
+// fill's identity itself.
+type FilteredFills struct {
+	Fills          []Fill
+	InvolvedTokens []TokenID
+}
+
+// FilteredSpotUserState is SpotUserStateFiltered's result: a SpotUserState
+// with only the matching balances, plus the distinct token identities
+// found among them.
+type FilteredSpotUserState struct {
+	State          *SpotUserState
+	InvolvedTokens []TokenID
+}
+
+// resolveFilter normalizes filter's mixed symbol/pair/index identifiers to
+// the set of canonical coin names (as they appear on Fill.Coin and
+// SpotBalance.Coin) the filter should match. It returns nil for a
+// zero-value Filter, meaning "match everything" rather than "match
+// nothing".
+func (i *Info) resolveFilter(filter Filter) map[string]bool {
+	if len(filter.Tokens) == 0 {
+		return nil
+	}
+
+	names := make(map[string]bool, len(filter.Tokens))
+	for _, token := range filter.Tokens {
+		name := token
+		if idx, err := strconv.Atoi(token); err == nil {
+			if resolved, ok := i.assets.tokenIndexToNameGet(idx); ok {
+				name = resolved
+			}
+		}
+		names[name] = true
+	}
+	return names
+}
+
+// involvedToken builds the TokenID for a coin name already known to match a
+// filter, using the same asset-index identity tickLotDecimals/CoinToAsset
+// use internally.
+func (i *Info) involvedToken(name string) TokenID {
+	asset, _ := i.assets.coinToAssetGet(name)
+	return TokenID{Name: name, Index: asset}
+}
+
+// UserFillsFiltered is UserFills restricted to fills whose coin matches
+// filter.
+func (i *Info) UserFillsFiltered(address string, filter Filter) (*FilteredFills, error) {
+	return i.UserFillsFilteredWithContext(context.Background(), address, filter)
+}
+
+// UserFillsFilteredWithContext is UserFillsFiltered with a caller-supplied
+// context.
+func (i *Info) UserFillsFilteredWithContext(ctx context.Context, address string, filter Filter) (*FilteredFills, error) {
+	fills, err := i.UserFillsWithContext(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	return i.filterFills(fills, filter), nil
+}
+
+// UserFillsByTimeFiltered is UserFillsByTime restricted to fills whose coin
+// matches filter.
+func (i *Info) UserFillsByTimeFiltered(address string, startTime int64, endTime *int64, filter Filter) (*FilteredFills, error) {
+	return i.UserFillsByTimeFilteredWithContext(context.Background(), address, startTime, endTime, filter)
+}
+
+// UserFillsByTimeFilteredWithContext is UserFillsByTimeFiltered with a
+// caller-supplied context.
+func (i *Info) UserFillsByTimeFilteredWithContext(ctx context.Context, address string, startTime int64, endTime *int64, filter Filter) (*FilteredFills, error) {
+	fills, err := i.UserFillsByTimeWithContext(ctx, address, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+	return i.filterFills(fills, filter), nil
+}
+
+func (i *Info) filterFills(fills []Fill, filter Filter) *FilteredFills {
+	allowed := i.resolveFilter(filter)
+
+	result := &FilteredFills{}
+	seen := make(map[string]bool)
+	for _, fill := range fills {
+		if allowed != nil && !allowed[fill.Coin] {
+			continue
+		}
+		result.Fills = append(result.Fills, fill)
+		if !seen[fill.Coin] {
+			seen[fill.Coin] = true
+			result.InvolvedTokens = append(result.InvolvedTokens, i.involvedToken(fill.Coin))
+		}
+	}
+	return result
+}
+
+// SpotUserStateFiltered is SpotUserState restricted to balances whose coin
+// matches filter.
+func (i *Info) SpotUserStateFiltered(address string, filter Filter) (*FilteredSpotUserState, error) {
+	return i.SpotUserStateFilteredWithContext(context.Background(), address, filter)
+}
+
+// SpotUserStateFilteredWithContext is SpotUserStateFiltered with a
+// caller-supplied context.
+func (i *Info) SpotUserStateFilteredWithContext(ctx context.Context, address string, filter Filter) (*FilteredSpotUserState, error) {
+	state, err := i.SpotUserStateWithContext(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := i.resolveFilter(filter)
+
+	filtered := *state
+	filtered.Balances = nil
+
+	result := &FilteredSpotUserState{State: &filtered}
+	seen := make(map[string]bool)
+	for _, balance := range state.Balances {
+		if allowed != nil && !allowed[balance.Coin] {
+			continue
+		}
+		filtered.Balances = append(filtered.Balances, balance)
+		if !seen[balance.Coin] {
+			seen[balance.Coin] = true
+			result.InvolvedTokens = append(result.InvolvedTokens, TokenID{Name: balance.Coin, Index: balance.Token})
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,61 @@
+package hyperliquid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testFilterInfo() *Info {
+	return &Info{
+		assets: &assetIndex{
+			coinToAsset:      map[string]int{"ETH": 4, "USDC": 0},
+			tokenIndexToName: map[int]string{1105: "HYPE"},
+		},
+	}
+}
+
+func testETHFills() []Fill {
+	return []Fill{
+		{Coin: "ETH", Dir: "Open Long", Price: "4307.4", Side: "B", Size: "0.0025", Time: 1755857898644, Tid: 1070455675927460},
+		{Coin: "ETH", Dir: "Close Long", Price: "4303.7", Side: "A", Size: "0.0025", Time: 1755857910772, Tid: 912424546441675},
+	}
+}
+
+func TestFilterFillsByCoinSymbolReturnsMatchingFills(t *testing.T) {
+	info := testFilterInfo()
+	fills := testETHFills()
+
+	result := info.filterFills(fills, Filter{Tokens: []string{"ETH"}})
+
+	assert.Equal(t, fills, result.Fills)
+	assert.Equal(t, []TokenID{{Name: "ETH", Index: 4}}, result.InvolvedTokens)
+}
+
+func TestFilterFillsByUnrelatedCoinReturnsNone(t *testing.T) {
+	info := testFilterInfo()
+	fills := testETHFills()
+
+	result := info.filterFills(fills, Filter{Tokens: []string{"USDC"}})
+
+	assert.Empty(t, result.Fills)
+	assert.Empty(t, result.InvolvedTokens)
+}
+
+func TestFilterFillsZeroValueFilterMatchesEverything(t *testing.T) {
+	info := testFilterInfo()
+	fills := testETHFills()
+
+	result := info.filterFills(fills, Filter{})
+
+	assert.Equal(t, fills, result.Fills)
+}
+
+func TestResolveFilterNormalizesTokenIndexToSymbol(t *testing.T) {
+	info := testFilterInfo()
+
+	resolved := info.resolveFilter(Filter{Tokens: []string{"1105"}})
+
+	assert.True(t, resolved["HYPE"])
+	assert.False(t, resolved["1105"])
+}
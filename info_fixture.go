@@ -0,0 +1,106 @@
+package hyperliquid
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// fixtureRecord is the on-disk shape WithRecorder writes and WithReplay
+// reads: one request/response pair for a single Info call, named by
+// coalesceKey's canonical hash of its path+payload so replay can look a
+// call back up without depending on map iteration order. Payload is kept
+// alongside Status/Body purely for human inspection of the corpus - only
+// Status/Body/Error round-trip through replayFixture.
+type fixtureRecord struct {
+	Endpoint string          `json:"endpoint"`
+	Path     string          `json:"path"`
+	Payload  json.RawMessage `json:"payload"`
+	Status   int             `json:"status"`
+	Body     json.RawMessage `json:"body,omitempty"`
+	Error    string          `json:"error,omitempty"`
+}
+
+// fixturePath is the file a given endpoint/key's fixture lives at,
+// grouped into one subdirectory per endpoint so a testdata/info/ corpus
+// reads as one directory per request type (meta/, userFills/, ...).
+func fixturePath(dir, endpoint, key string) string {
+	return filepath.Join(dir, endpoint, key+".json")
+}
+
+// recordFixture persists one (path, payload) -> (resp, err) call to
+// i.recordDir, keyed by coalesceKey so a later WithReplay(i.recordDir)
+// can look it back up. Write failures are swallowed rather than
+// surfaced to the caller - recording is a side effect of an otherwise
+// successful live call, and a fixture that fails to write shouldn't fail
+// the request that produced it.
+func (i *Info) recordFixture(path string, payload any, resp []byte, callErr error) {
+	key, err := coalesceKey(path, payload)
+	if err != nil {
+		return
+	}
+
+	rec := fixtureRecord{
+		Endpoint: endpointNameFromPayload(payload, path),
+		Path:     path,
+		Status:   http.StatusOK,
+	}
+	if canon, err := canonicalJSON(payload); err == nil {
+		rec.Payload = canon
+	}
+
+	switch {
+	case callErr == nil:
+		rec.Body = json.RawMessage(resp)
+	default:
+		rec.Error = callErr.Error()
+		var apiErr *InfoAPIError
+		if errors.As(callErr, &apiErr) {
+			rec.Status = apiErr.Status
+		} else {
+			rec.Status = 0
+		}
+	}
+
+	file := fixturePath(i.recordDir, rec.Endpoint, key)
+	if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+		return
+	}
+	data, err := json.MarshalIndent(rec, "", "  ")
+	if err != nil {
+		return
+	}
+	_ = os.WriteFile(file, data, 0o644)
+}
+
+// replayFixture shorts out a live call with a previously recorded
+// fixture from i.replayDir, failing loudly (rather than falling back to
+// a live request) when no fixture matches path+payload, since a cache
+// miss during replay almost always means the corpus is stale or the
+// caller changed which fields it sends.
+func (i *Info) replayFixture(path string, payload any) ([]byte, error) {
+	key, err := coalesceKey(path, payload)
+	if err != nil {
+		return nil, fmt.Errorf("replay fixture: key payload: %w", err)
+	}
+	endpoint := endpointNameFromPayload(payload, path)
+	file := fixturePath(i.replayDir, endpoint, key)
+
+	raw, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("replay fixture: no recorded fixture for %q at %s: %w", endpoint, file, err)
+	}
+
+	var rec fixtureRecord
+	if err := json.Unmarshal(raw, &rec); err != nil {
+		return nil, fmt.Errorf("replay fixture: decode %s: %w", file, err)
+	}
+
+	if rec.Error != "" {
+		return nil, &InfoAPIError{Status: rec.Status, Message: rec.Error}
+	}
+	return rec.Body, nil
+}
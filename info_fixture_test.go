@@ -0,0 +1,185 @@
+package hyperliquid
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const infoFixtureCorpusDir = "testdata/info"
+
+func testReplayInfo(t *testing.T) *Info {
+	t.Helper()
+	info, err := NewInfoWithContext(context.Background(), MainnetAPIURL, true, &Meta{}, &SpotMeta{}, WithReplay(infoFixtureCorpusDir))
+	require.NoError(t, err)
+	return info
+}
+
+func TestReplayFixtureBootstrapsMetaAndSpotMetaFromCorpus(t *testing.T) {
+	info, err := NewInfoWithContext(context.Background(), MainnetAPIURL, true, nil, nil, WithReplay(infoFixtureCorpusDir))
+	require.NoError(t, err)
+
+	asset, ok := info.CoinToAsset("BTC")
+	assert.True(t, ok)
+	assert.Equal(t, 0, asset)
+}
+
+func TestReplayFixtureMeta(t *testing.T) {
+	info := testReplayInfo(t)
+
+	meta, err := info.MetaWithContext(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, meta.Universe, 2)
+	assert.Equal(t, "BTC", meta.Universe[0].Name)
+	assert.Equal(t, 5, meta.Universe[0].SzDecimals)
+	assert.Equal(t, 40, meta.Universe[0].MaxLeverage)
+	require.Len(t, meta.MarginTables, 2)
+}
+
+func TestReplayFixtureSpotMeta(t *testing.T) {
+	info := testReplayInfo(t)
+
+	spotMeta, err := info.SpotMetaWithContext(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, spotMeta.Tokens, 2)
+	assert.Equal(t, "USDC", spotMeta.Tokens[0].Name)
+}
+
+func TestReplayFixtureMetaAndAssetCtxs(t *testing.T) {
+	info := testReplayInfo(t)
+
+	mac, err := info.MetaAndAssetCtxsWithContext(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, mac.Meta.Universe, 2)
+	require.Len(t, mac.Ctxs, 2)
+	assert.Equal(t, "60000.0", mac.Ctxs[0].MarkPx)
+}
+
+func TestReplayFixtureUserState(t *testing.T) {
+	info := testReplayInfo(t)
+
+	userState, err := info.UserStateWithContext(context.Background(), "0x000000000000000000000000000000000000aa")
+
+	require.NoError(t, err)
+	assert.Equal(t, "10000.0", userState.MarginSummary.AccountValue)
+	require.Len(t, userState.AssetPositions, 1)
+	assert.Equal(t, "BTC", userState.AssetPositions[0].Position.Coin)
+}
+
+func TestReplayFixtureOpenOrders(t *testing.T) {
+	info := testReplayInfo(t)
+
+	orders, err := info.OpenOrdersWithContext(context.Background(), "0x000000000000000000000000000000000000aa")
+
+	require.NoError(t, err)
+	require.Len(t, orders, 2)
+	assert.Equal(t, "ETH", orders[0].Coin)
+}
+
+func TestReplayFixtureUserFills(t *testing.T) {
+	info := testReplayInfo(t)
+
+	fills, err := info.UserFillsWithContext(context.Background(), "0x000000000000000000000000000000000000aa")
+
+	require.NoError(t, err)
+	require.Len(t, fills, 1)
+	assert.Equal(t, "BTC", fills[0].Coin)
+	assert.Equal(t, int64(456), fills[0].Tid)
+}
+
+func TestReplayFixtureL2Snapshot(t *testing.T) {
+	info := testReplayInfo(t)
+
+	book, err := info.L2SnapshotWithContext(context.Background(), "ETH")
+
+	require.NoError(t, err)
+	assert.Equal(t, "ETH", book.Coin)
+	require.Len(t, book.Levels, 2)
+}
+
+func TestReplayFixtureCandlesSnapshot(t *testing.T) {
+	info := testReplayInfo(t)
+
+	candles, err := info.CandlesSnapshotWithContext(context.Background(), "ETH", "1h", 1700000000000, 1700003600000)
+
+	require.NoError(t, err)
+	require.Len(t, candles, 1)
+	assert.Equal(t, "1h", candles[0].Interval)
+	assert.Equal(t, "ETH", candles[0].Symbol)
+}
+
+func TestReplayFixtureMissReturnsLoudError(t *testing.T) {
+	info := testReplayInfo(t)
+
+	_, err := info.UserFeesWithContext(context.Background(), "0x000000000000000000000000000000000000bb")
+
+	require.Error(t, err)
+}
+
+func TestRecordFixtureThenReplayRoundTrips(t *testing.T) {
+	dir := t.TempDir()
+	recorder := &Info{recordDir: dir}
+
+	recorder.recordFixture("/info", map[string]any{"type": "allMids"}, []byte(`{"BTC":"60000.0"}`), nil)
+
+	player := &Info{replayDir: dir}
+	resp, err := player.post(context.Background(), "/info", map[string]any{"type": "allMids"})
+
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"BTC":"60000.0"}`, string(resp))
+}
+
+func TestRecordFixturePersistsAPIErrorStatus(t *testing.T) {
+	dir := t.TempDir()
+	recorder := &Info{recordDir: dir}
+	apiErr := &InfoAPIError{Status: 422, Code: 1, Message: "bad request"}
+
+	recorder.recordFixture("/info", map[string]any{"type": "allMids"}, nil, apiErr)
+
+	player := &Info{replayDir: dir}
+	_, err := player.post(context.Background(), "/info", map[string]any{"type": "allMids"})
+
+	var replayed *InfoAPIError
+	require.True(t, errors.As(err, &replayed))
+	assert.Equal(t, 422, replayed.Status)
+	assert.Equal(t, apiErr.Error(), replayed.Message)
+}
+
+func TestReplayFixtureCacheMissNamesMissingFile(t *testing.T) {
+	dir := t.TempDir()
+	player := &Info{replayDir: dir}
+
+	_, err := player.post(context.Background(), "/info", map[string]any{"type": "allMids"})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no recorded fixture")
+}
+
+func TestFixturePathGroupsByEndpoint(t *testing.T) {
+	path := fixturePath("testdata/info", "meta", "abc123")
+
+	assert.Equal(t, filepath.Join("testdata/info", "meta", "abc123.json"), path)
+}
+
+func TestFixtureCorpusHasFixturesForEveryEndpoint(t *testing.T) {
+	entries, err := os.ReadDir(infoFixtureCorpusDir)
+	require.NoError(t, err)
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		endpoint := entry.Name()
+		files, err := os.ReadDir(filepath.Join(infoFixtureCorpusDir, endpoint))
+		require.NoError(t, err)
+		assert.NotEmpty(t, files, "endpoint %s has no fixtures", endpoint)
+	}
+}
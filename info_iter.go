@@ -0,0 +1,183 @@
+package hyperliquid
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+// timeRangePageSize is the row count Hyperliquid's time-range endpoints
+// (userFillsByTime, fundingHistory, userFunding, candleSnapshot) cap a
+// single response at. iterTimeRange treats a page shorter than this as
+// the end of the range, since a full page means there may be more rows
+// past it.
+const timeRangePageSize = 2000
+
+// iterTimeRange is the paging primitive IterUserFills and its funding/
+// candle siblings below share: fetch returns one page starting at start
+// (bounded by end when non-nil), getTime extracts a row's timestamp for
+// advancing the window past it, and getKey extracts a row's identity for
+// deduplicating the boundary row Hyperliquid's "from startTime" paging
+// can return again at the head of the next page. Each non-nil error is
+// yielded once and ends iteration - range over the result and
+// return/break on a non-nil error is the expected way to stop early.
+func iterTimeRange[T any](
+	fetch func(start int64, end *int64) ([]T, error),
+	getTime func(T) int64,
+	getKey func(T) any,
+	startTime int64,
+	endTime *int64,
+	pageSize int,
+) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		seen := make(map[any]bool)
+		cursor := startTime
+
+		for {
+			page, err := fetch(cursor, endTime)
+			if err != nil {
+				yield(*new(T), err)
+				return
+			}
+
+			newRows := 0
+			for _, row := range page {
+				key := getKey(row)
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+				newRows++
+				if !yield(row, nil) {
+					return
+				}
+			}
+
+			if len(page) < pageSize {
+				return
+			}
+			if newRows == 0 {
+				// The endpoint returned a full page but every row in it was
+				// already seen: the window isn't advancing, so stop instead
+				// of refetching the same page forever.
+				return
+			}
+
+			lastTime := getTime(page[len(page)-1])
+			if endTime != nil && lastTime >= *endTime {
+				return
+			}
+			cursor = lastTime + 1
+		}
+	}
+}
+
+// fetchAllTimeRange concatenates every row seq yields into one slice,
+// stopping with an error once more than maxRows rows have accumulated - a
+// guard against an unbounded time range silently consuming unbounded
+// memory. maxRows <= 0 means unlimited.
+func fetchAllTimeRange[T any](seq iter.Seq2[T, error], maxRows int) ([]T, error) {
+	var rows []T
+	for row, err := range seq {
+		if err != nil {
+			return rows, err
+		}
+		rows = append(rows, row)
+		if maxRows > 0 && len(rows) > maxRows {
+			return rows, fmt.Errorf("fetch all: exceeded max rows %d", maxRows)
+		}
+	}
+	return rows, nil
+}
+
+// IterUserFills streams every fill for address in [startTime, endTime],
+// walking Hyperliquid's userFillsByTime pagination by advancing startTime
+// to the last page's last fill's Time+1 whenever a page comes back full,
+// and deduplicating by Tid (a fill's unique trade id) since the boundary
+// fill can reappear at the start of the next page.
+func (i *Info) IterUserFills(ctx context.Context, address string, startTime int64, endTime *int64) iter.Seq2[Fill, error] {
+	return iterTimeRange(
+		func(start int64, end *int64) ([]Fill, error) {
+			return i.UserFillsByTimeWithContext(ctx, address, start, end)
+		},
+		func(f Fill) int64 { return f.Time },
+		func(f Fill) any { return f.Tid },
+		startTime, endTime,
+		timeRangePageSize,
+	)
+}
+
+// FetchAllUserFillsByTime concatenates every page IterUserFills yields
+// into one slice, stopping with an error once more than maxRows fills
+// have accumulated. maxRows <= 0 means unlimited, matching
+// UserFillsByTime's original (unpaginated, unguarded) behavior.
+func (i *Info) FetchAllUserFillsByTime(ctx context.Context, address string, startTime int64, endTime *int64, maxRows int) ([]Fill, error) {
+	return fetchAllTimeRange(i.IterUserFills(ctx, address, startTime, endTime), maxRows)
+}
+
+// IterFundingHistory streams coin's funding history in [startTime,
+// endTime], paging the same way IterUserFills does, deduplicating by
+// Time since funding history has one entry per coin per funding
+// interval.
+func (i *Info) IterFundingHistory(ctx context.Context, coin string, startTime int64, endTime *int64) iter.Seq2[FundingHistory, error] {
+	return iterTimeRange(
+		func(start int64, end *int64) ([]FundingHistory, error) {
+			return i.FundingHistoryWithContext(ctx, coin, start, end)
+		},
+		func(f FundingHistory) int64 { return f.Time },
+		func(f FundingHistory) any { return f.Time },
+		startTime, endTime,
+		timeRangePageSize,
+	)
+}
+
+// FetchAllFundingHistory is IterFundingHistory concatenated into one
+// slice, guarded by maxRows the same way FetchAllUserFillsByTime is.
+func (i *Info) FetchAllFundingHistory(ctx context.Context, coin string, startTime int64, endTime *int64, maxRows int) ([]FundingHistory, error) {
+	return fetchAllTimeRange(i.IterFundingHistory(ctx, coin, startTime, endTime), maxRows)
+}
+
+// IterUserFundingHistory streams user's funding history in [startTime,
+// endTime], paging and deduplicating the same way IterFundingHistory
+// does.
+func (i *Info) IterUserFundingHistory(ctx context.Context, user string, startTime int64, endTime *int64) iter.Seq2[UserFundingHistory, error] {
+	return iterTimeRange(
+		func(start int64, end *int64) ([]UserFundingHistory, error) {
+			return i.UserFundingHistoryWithContext(ctx, user, start, end)
+		},
+		func(f UserFundingHistory) int64 { return f.Time },
+		func(f UserFundingHistory) any { return f.Time },
+		startTime, endTime,
+		timeRangePageSize,
+	)
+}
+
+// FetchAllUserFundingHistory is IterUserFundingHistory concatenated into
+// one slice, guarded by maxRows the same way FetchAllUserFillsByTime is.
+func (i *Info) FetchAllUserFundingHistory(ctx context.Context, user string, startTime int64, endTime *int64, maxRows int) ([]UserFundingHistory, error) {
+	return fetchAllTimeRange(i.IterUserFundingHistory(ctx, user, startTime, endTime), maxRows)
+}
+
+// IterCandlesSnapshot streams name's candles in [startTime, endTime] at
+// interval, advancing the window past each page by the last candle's
+// TimeClose+1 and deduplicating by TimeOpen, since a page's last candle
+// can still be open (its TimeClose in the future) and so reappear,
+// unchanged or updated, at the head of the next page.
+func (i *Info) IterCandlesSnapshot(ctx context.Context, name, interval string, startTime, endTime int64) iter.Seq2[Candle, error] {
+	bound := endTime
+	return iterTimeRange(
+		func(start int64, _ *int64) ([]Candle, error) {
+			return i.CandlesSnapshotWithContext(ctx, name, interval, start, endTime)
+		},
+		func(c Candle) int64 { return c.TimeClose },
+		func(c Candle) any { return c.TimeOpen },
+		startTime, &bound,
+		timeRangePageSize,
+	)
+}
+
+// FetchAllCandlesSnapshot is IterCandlesSnapshot concatenated into one
+// slice, guarded by maxRows the same way FetchAllUserFillsByTime is.
+func (i *Info) FetchAllCandlesSnapshot(ctx context.Context, name, interval string, startTime, endTime int64, maxRows int) ([]Candle, error) {
+	return fetchAllTimeRange(i.IterCandlesSnapshot(ctx, name, interval, startTime, endTime), maxRows)
+}
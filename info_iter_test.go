@@ -0,0 +1,187 @@
+package hyperliquid
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeTimeRow struct {
+	Time int64
+	ID   int
+}
+
+func TestIterTimeRangeStopsOnShortPage(t *testing.T) {
+	pages := [][]fakeTimeRow{
+		{{Time: 1, ID: 1}, {Time: 2, ID: 2}, {Time: 3, ID: 3}},
+		{{Time: 4, ID: 4}},
+	}
+	calls := 0
+	fetch := func(start int64, end *int64) ([]fakeTimeRow, error) {
+		page := pages[calls]
+		calls++
+		return page, nil
+	}
+
+	var got []fakeTimeRow
+	for row, err := range iterTimeRange(fetch,
+		func(r fakeTimeRow) int64 { return r.Time },
+		func(r fakeTimeRow) any { return r.ID },
+		1, nil, 3) {
+		require.NoError(t, err)
+		got = append(got, row)
+	}
+
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, []fakeTimeRow{{1, 1}, {2, 2}, {3, 3}, {4, 4}}, got)
+}
+
+func TestIterTimeRangeDedupesBoundaryRow(t *testing.T) {
+	pages := [][]fakeTimeRow{
+		{{Time: 1, ID: 1}, {Time: 2, ID: 2}, {Time: 2, ID: 3}},
+		{{Time: 2, ID: 3}, {Time: 3, ID: 4}},
+	}
+	calls := 0
+	fetch := func(start int64, end *int64) ([]fakeTimeRow, error) {
+		page := pages[calls]
+		calls++
+		return page, nil
+	}
+
+	var ids []int
+	for row, err := range iterTimeRange(fetch,
+		func(r fakeTimeRow) int64 { return r.Time },
+		func(r fakeTimeRow) any { return r.ID },
+		1, nil, 3) {
+		require.NoError(t, err)
+		ids = append(ids, row.ID)
+	}
+
+	assert.Equal(t, []int{1, 2, 3, 4}, ids)
+}
+
+func TestIterTimeRangeStopsWhenEndTimeCrossed(t *testing.T) {
+	pages := [][]fakeTimeRow{
+		{{Time: 1, ID: 1}, {Time: 2, ID: 2}, {Time: 5, ID: 3}},
+		{{Time: 6, ID: 4}, {Time: 7, ID: 5}, {Time: 8, ID: 6}},
+	}
+	calls := 0
+	fetch := func(start int64, end *int64) ([]fakeTimeRow, error) {
+		page := pages[calls]
+		calls++
+		return page, nil
+	}
+	end := int64(5)
+
+	var ids []int
+	for row, err := range iterTimeRange(fetch,
+		func(r fakeTimeRow) int64 { return r.Time },
+		func(r fakeTimeRow) any { return r.ID },
+		1, &end, 3) {
+		require.NoError(t, err)
+		ids = append(ids, row.ID)
+	}
+
+	assert.Equal(t, []int{1, 2, 3}, ids)
+	assert.Equal(t, 1, calls)
+}
+
+func TestIterTimeRangeYieldsErrorAndStops(t *testing.T) {
+	fetchErr := errors.New("boom")
+	calls := 0
+	fetch := func(start int64, end *int64) ([]fakeTimeRow, error) {
+		calls++
+		return nil, fetchErr
+	}
+
+	var sawErr error
+	for _, err := range iterTimeRange(fetch,
+		func(r fakeTimeRow) int64 { return r.Time },
+		func(r fakeTimeRow) any { return r.ID },
+		1, nil, 3) {
+		sawErr = err
+	}
+
+	assert.ErrorIs(t, sawErr, fetchErr)
+	assert.Equal(t, 1, calls)
+}
+
+func TestIterTimeRangeStopsWhenNoNewRowsInFullPage(t *testing.T) {
+	calls := 0
+	fetch := func(start int64, end *int64) ([]fakeTimeRow, error) {
+		calls++
+		return []fakeTimeRow{{Time: 1, ID: 1}, {Time: 2, ID: 2}, {Time: 3, ID: 3}}, nil
+	}
+
+	var got []fakeTimeRow
+	for row, err := range iterTimeRange(fetch,
+		func(r fakeTimeRow) int64 { return r.Time },
+		func(r fakeTimeRow) any { return r.ID },
+		1, nil, 3) {
+		require.NoError(t, err)
+		got = append(got, row)
+	}
+
+	assert.Equal(t, 2, calls)
+	assert.Len(t, got, 3)
+}
+
+func TestIterTimeRangeStopsEarlyWhenConsumerBreaks(t *testing.T) {
+	calls := 0
+	fetch := func(start int64, end *int64) ([]fakeTimeRow, error) {
+		calls++
+		return []fakeTimeRow{{Time: 1, ID: 1}, {Time: 2, ID: 2}, {Time: 3, ID: 3}}, nil
+	}
+
+	var got []fakeTimeRow
+	for row, err := range iterTimeRange(fetch,
+		func(r fakeTimeRow) int64 { return r.Time },
+		func(r fakeTimeRow) any { return r.ID },
+		1, nil, 3) {
+		require.NoError(t, err)
+		got = append(got, row)
+		if len(got) == 1 {
+			break
+		}
+	}
+
+	assert.Equal(t, 1, calls)
+	assert.Len(t, got, 1)
+}
+
+func TestFetchAllTimeRangeConcatenatesRows(t *testing.T) {
+	calls := 0
+	fetch := func(start int64, end *int64) ([]fakeTimeRow, error) {
+		calls++
+		if calls == 1 {
+			return []fakeTimeRow{{Time: 1, ID: 1}, {Time: 2, ID: 2}, {Time: 3, ID: 3}}, nil
+		}
+		return []fakeTimeRow{{Time: 4, ID: 4}}, nil
+	}
+	seq := iterTimeRange(fetch,
+		func(r fakeTimeRow) int64 { return r.Time },
+		func(r fakeTimeRow) any { return r.ID },
+		1, nil, 3)
+
+	rows, err := fetchAllTimeRange(seq, 0)
+
+	require.NoError(t, err)
+	assert.Len(t, rows, 4)
+}
+
+func TestFetchAllTimeRangeStopsWithErrorPastMaxRows(t *testing.T) {
+	fetch := func(start int64, end *int64) ([]fakeTimeRow, error) {
+		return []fakeTimeRow{{Time: 1, ID: 1}, {Time: 2, ID: 2}, {Time: 3, ID: 3}}, nil
+	}
+	seq := iterTimeRange(fetch,
+		func(r fakeTimeRow) int64 { return r.Time },
+		func(r fakeTimeRow) any { return r.ID },
+		1, nil, 3)
+
+	rows, err := fetchAllTimeRange(seq, 2)
+
+	require.Error(t, err)
+	assert.Len(t, rows, 3)
+}
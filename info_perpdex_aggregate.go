@@ -0,0 +1,144 @@
+package hyperliquid
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// aggregatedMetaParallelism bounds how many per-dex MetaAndAssetCtxs calls
+// AllPerpDexsMetaAndAssetCtxs issues concurrently, the same bounded-worker-
+// pool shape BatchBuilder.Submit uses for per-action requests.
+const aggregatedMetaParallelism = 8
+
+// defaultDexErrorKey is the key AllPerpDexsMetaAndAssetCtxs uses in
+// AggregatedMeta.Errors for the default dex, which PerpDexs represents as
+// "" rather than a name.
+const defaultDexErrorKey = "<default>"
+
+// AggregatedAssetInfo is one perp dex's AssetInfo tagged with the dex it
+// came from, so callers iterating the merged set can tell a
+// builder-deployed "xyz:TSLA" apart from an identically-named asset on the
+// default dex.
+type AggregatedAssetInfo struct {
+	AssetInfo
+	Dex string
+}
+
+// AggregatedAssetCtx is one perp dex's AssetCtx tagged with the dex it
+// came from.
+type AggregatedAssetCtx struct {
+	AssetCtx
+	Dex string
+}
+
+// AggregatedMeta is AllPerpDexsMetaAndAssetCtxs' result: every dex's
+// AssetInfo/AssetCtx merged into one symbol namespace, keyed by fully
+// qualified name (qualifiedAssetName: "BTC" on the default dex, "xyz:TSLA"
+// on builder-deployed dex "xyz"). Errors carries one entry per dex whose
+// MetaAndAssetCtxs call failed, keyed by dex name (defaultDexErrorKey for
+// the default dex), so one unreachable dex
+// does not fail the whole call.
+type AggregatedMeta struct {
+	Assets map[string]AggregatedAssetInfo
+	Ctxs   map[string]AggregatedAssetCtx
+	Errors map[string]error
+}
+
+// qualifiedAssetName prefixes name with dex + ":" for every dex except the
+// default (empty) one, matching Hyperliquid's own builder-deployed-dex
+// symbol convention (e.g. "xyz:TSLA").
+func qualifiedAssetName(dex, name string) string {
+	if dex == "" {
+		return name
+	}
+	return dex + ":" + name
+}
+
+// LookupAsset returns the AggregatedAssetInfo for a fully qualified name as
+// produced by qualifiedAssetName, e.g. "BTC" or "xyz:TSLA".
+func (m *AggregatedMeta) LookupAsset(qualifiedName string) (AggregatedAssetInfo, bool) {
+	info, ok := m.Assets[qualifiedName]
+	return info, ok
+}
+
+// AssetsByDex returns every asset tagged with dex, sorted by name for a
+// deterministic result (Assets is a map and so has no iteration order of
+// its own).
+func (m *AggregatedMeta) AssetsByDex(dex string) []AggregatedAssetInfo {
+	var out []AggregatedAssetInfo
+	for _, info := range m.Assets {
+		if info.Dex == dex {
+			out = append(out, info)
+		}
+	}
+	sort.Slice(out, func(a, b int) bool { return out[a].Name < out[b].Name })
+	return out
+}
+
+// AllPerpDexsMetaAndAssetCtxs calls MetaAndAssetCtxs for the default perp
+// dex plus every dex PerpDexs reports, concurrently with bounded
+// parallelism, and merges the results into one cross-dex AggregatedMeta.
+// This is the pattern TestPerpDexLimits hand-rolls (walk PerpDexs, then
+// loop calling per-dex endpoints) turned into a first-class API: a failed
+// per-dex call is recorded in AggregatedMeta.Errors rather than failing the
+// whole call, since one dex being unreachable shouldn't hide every other
+// dex's data.
+func (i *Info) AllPerpDexsMetaAndAssetCtxs(ctx context.Context) (*AggregatedMeta, error) {
+	dexs, err := i.PerpDexsWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetch perp dexs: %w", err)
+	}
+
+	result := &AggregatedMeta{
+		Assets: make(map[string]AggregatedAssetInfo),
+		Ctxs:   make(map[string]AggregatedAssetCtx),
+		Errors: make(map[string]error),
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, aggregatedMetaParallelism)
+
+	for _, dex := range dexs {
+		wg.Add(1)
+		go func(dex string) {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			var mac *MetaAndAssetCtxs
+			var err error
+			if dex == "" {
+				mac, err = i.MetaAndAssetCtxsWithContext(ctx)
+			} else {
+				mac, err = i.MetaAndAssetCtxsForDexWithContext(ctx, dex)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				key := dex
+				if key == "" {
+					key = defaultDexErrorKey
+				}
+				result.Errors[key] = err
+				return
+			}
+
+			for idx, assetInfo := range mac.Meta.Universe {
+				name := qualifiedAssetName(dex, assetInfo.Name)
+				result.Assets[name] = AggregatedAssetInfo{AssetInfo: assetInfo, Dex: dex}
+				if idx < len(mac.Ctxs) {
+					result.Ctxs[name] = AggregatedAssetCtx{AssetCtx: mac.Ctxs[idx], Dex: dex}
+				}
+			}
+		}(dex)
+	}
+	wg.Wait()
+
+	return result, nil
+}
@@ -0,0 +1,45 @@
+package hyperliquid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQualifiedAssetNamePrefixesNonDefaultDex(t *testing.T) {
+	assert.Equal(t, "BTC", qualifiedAssetName("", "BTC"))
+	assert.Equal(t, "xyz:TSLA", qualifiedAssetName("xyz", "TSLA"))
+}
+
+func testAggregatedMeta() *AggregatedMeta {
+	return &AggregatedMeta{
+		Assets: map[string]AggregatedAssetInfo{
+			"BTC":      {AssetInfo: AssetInfo{Name: "BTC"}, Dex: ""},
+			"xyz:TSLA": {AssetInfo: AssetInfo{Name: "TSLA"}, Dex: "xyz"},
+			"xyz:AAPL": {AssetInfo: AssetInfo{Name: "AAPL"}, Dex: "xyz"},
+		},
+		Ctxs:   map[string]AggregatedAssetCtx{},
+		Errors: map[string]error{},
+	}
+}
+
+func TestAggregatedMetaLookupAsset(t *testing.T) {
+	m := testAggregatedMeta()
+
+	info, ok := m.LookupAsset("xyz:TSLA")
+	assert.True(t, ok)
+	assert.Equal(t, "TSLA", info.Name)
+	assert.Equal(t, "xyz", info.Dex)
+
+	_, ok = m.LookupAsset("nope")
+	assert.False(t, ok)
+}
+
+func TestAggregatedMetaAssetsByDexIsSortedByName(t *testing.T) {
+	m := testAggregatedMeta()
+
+	assets := m.AssetsByDex("xyz")
+
+	assert.Equal(t, []string{"AAPL", "TSLA"}, []string{assets[0].Name, assets[1].Name})
+	assert.Empty(t, m.AssetsByDex("unknown"))
+}
@@ -0,0 +1,319 @@
+package hyperliquid
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// MetaDiff describes what changed between two asset-index snapshots, as
+// passed to every func registered with Info.Subscribe.
+type MetaDiff struct {
+	// NewCoins are perp or spot symbols present in the new snapshot but
+	// absent from the previous one.
+	NewCoins []string
+	// ChangedDecimals maps a symbol present in both snapshots to its new
+	// SzDecimals, for every symbol whose SzDecimals changed.
+	ChangedDecimals map[string]int
+}
+
+// isEmpty reports whether diff carries no changes, so refreshOnce can
+// skip notifying subscribers of a refresh that found nothing new.
+func (d MetaDiff) isEmpty() bool {
+	return len(d.NewCoins) == 0 && len(d.ChangedDecimals) == 0
+}
+
+// assetIndex is Info's coinToAsset/nameToCoin/assetToDecimal/
+// tokenIndexToName bootstrap state. It is read through an RWMutex so the
+// background refresher (Info.refreshLoop) can hot-swap in a freshly
+// fetched snapshot without racing concurrent readers like NameToAsset.
+type assetIndex struct {
+	mu               sync.RWMutex
+	coinToAsset      map[string]int
+	nameToCoin       map[string]string
+	assetToDecimal   map[int]int
+	tokenIndexToName map[int]string
+}
+
+func newAssetIndex() *assetIndex {
+	return &assetIndex{
+		coinToAsset:      make(map[string]int),
+		nameToCoin:       make(map[string]string),
+		assetToDecimal:   make(map[int]int),
+		tokenIndexToName: make(map[int]string),
+	}
+}
+
+// buildAssetIndex derives a fresh assetIndex from meta/spotMeta: perp
+// assets keyed by universe index, spot assets offset by
+// spotAssetIndexOffset, and each spot token's own numeric index mapped
+// back to its symbol so Filter can accept either form. This is the same
+// mapping NewInfo has always built at construction; refreshOnce calls it
+// again on every background refresh.
+func buildAssetIndex(meta *Meta, spotMeta *SpotMeta) *assetIndex {
+	idx := newAssetIndex()
+
+	for asset, assetInfo := range meta.Universe {
+		idx.coinToAsset[assetInfo.Name] = asset
+		idx.nameToCoin[assetInfo.Name] = assetInfo.Name
+		idx.assetToDecimal[asset] = assetInfo.SzDecimals
+	}
+
+	for _, spotInfo := range spotMeta.Universe {
+		asset := spotInfo.Index + spotAssetIndexOffset
+		idx.coinToAsset[spotInfo.Name] = asset
+		idx.nameToCoin[spotInfo.Name] = spotInfo.Name
+		idx.assetToDecimal[asset] = spotMeta.Tokens[spotInfo.Tokens[0]].SzDecimals
+	}
+
+	for _, token := range spotMeta.Tokens {
+		idx.tokenIndexToName[token.Index] = token.Name
+	}
+
+	return idx
+}
+
+// coinToAssetGet and its siblings below tolerate a nil receiver (an Info
+// built without going through NewInfo/NewInfoWithContext, as some tests
+// do) the same way a nil map read does: a miss rather than a panic.
+
+func (a *assetIndex) coinToAssetGet(name string) (int, bool) {
+	if a == nil {
+		return 0, false
+	}
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	v, ok := a.coinToAsset[name]
+	return v, ok
+}
+
+func (a *assetIndex) nameToCoinGet(name string) (string, bool) {
+	if a == nil {
+		return "", false
+	}
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	v, ok := a.nameToCoin[name]
+	return v, ok
+}
+
+func (a *assetIndex) assetToDecimalGet(asset int) (int, bool) {
+	if a == nil {
+		return 0, false
+	}
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	v, ok := a.assetToDecimal[asset]
+	return v, ok
+}
+
+func (a *assetIndex) tokenIndexToNameGet(idx int) (string, bool) {
+	if a == nil {
+		return "", false
+	}
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	v, ok := a.tokenIndexToName[idx]
+	return v, ok
+}
+
+// swap replaces a's maps with next's under the write lock and returns the
+// MetaDiff between the two: a symbol in next.coinToAsset absent from the
+// previous coinToAsset is a new listing, and any symbol whose
+// assetToDecimal entry changed is reported in ChangedDecimals.
+func (a *assetIndex) swap(next *assetIndex) MetaDiff {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	diff := MetaDiff{ChangedDecimals: make(map[string]int)}
+	for name, asset := range next.coinToAsset {
+		prevAsset, existed := a.coinToAsset[name]
+		if !existed {
+			diff.NewCoins = append(diff.NewCoins, name)
+			continue
+		}
+		if prevDec, newDec := a.assetToDecimal[prevAsset], next.assetToDecimal[asset]; prevDec != newDec {
+			diff.ChangedDecimals[name] = newDec
+		}
+	}
+
+	a.coinToAsset = next.coinToAsset
+	a.nameToCoin = next.nameToCoin
+	a.assetToDecimal = next.assetToDecimal
+	a.tokenIndexToName = next.tokenIndexToName
+
+	return diff
+}
+
+// CoinToAsset resolves coin (a canonical symbol like "BTC" or a spot pair
+// like "PURR/USDC") to its asset index, the index CreateOrderRequest and
+// the rest of Exchange's order helpers expect. The second return reports
+// whether coin is known to the current asset index.
+func (i *Info) CoinToAsset(coin string) (int, bool) {
+	return i.assets.coinToAssetGet(coin)
+}
+
+// AssetToDecimal returns asset's cached SzDecimals (its lot size), and
+// whether asset is known to the current asset index.
+func (i *Info) AssetToDecimal(asset int) (int, bool) {
+	return i.assets.assetToDecimalGet(asset)
+}
+
+// NameToCoin resolves a display name to its canonical coin string (the
+// form Fill.Coin/SpotBalance.Coin use), and whether name is known to the
+// current asset index.
+func (i *Info) NameToCoin(name string) (string, bool) {
+	return i.assets.nameToCoinGet(name)
+}
+
+// coinFor is NameToCoin without the ok return, for call sites that
+// already tolerate an unresolved name turning into an empty "coin" field
+// on the outgoing request (the API then reports its own "unknown coin"
+// error rather than this library doing so preemptively).
+func (i *Info) coinFor(name string) string {
+	coin, _ := i.assets.nameToCoinGet(name)
+	return coin
+}
+
+// MetaRefreshConfig configures Info's background asset-index refresher;
+// see WithMetaRefresh.
+type MetaRefreshConfig struct {
+	// Interval is how often the refresher re-fetches Meta/SpotMeta and
+	// diffs the result against the current asset index. Zero (the
+	// default) disables the refresher: NewInfo/NewInfoWithContext still
+	// bootstrap the index once at construction, but it is never hot-
+	// reloaded afterward.
+	Interval time.Duration
+	// MaxBackoff caps the jittered backoff a failed refresh waits before
+	// retrying, so a transient outage doesn't wedge the refresher into
+	// an ever-growing delay. Defaults to 10 * Interval when zero.
+	MaxBackoff time.Duration
+}
+
+// defaultMetaRefreshMaxBackoff is MetaRefreshConfig.MaxBackoff's default
+// when unset.
+func defaultMetaRefreshMaxBackoff(interval time.Duration) time.Duration {
+	return interval * 10
+}
+
+// Subscribe registers fn to be called with the MetaDiff computed after
+// each background refresh that changes the asset index (a new listing or
+// a szDecimals change for an existing one). It returns an unsubscribe
+// func that removes fn. Subscribe never fires when no WithMetaRefresh
+// interval was configured, since nothing ever refreshes.
+func (i *Info) Subscribe(fn func(diff MetaDiff)) (unsubscribe func()) {
+	i.subscribersMu.Lock()
+	defer i.subscribersMu.Unlock()
+
+	i.subscribers = append(i.subscribers, fn)
+	idx := len(i.subscribers) - 1
+
+	return func() {
+		i.subscribersMu.Lock()
+		defer i.subscribersMu.Unlock()
+		i.subscribers[idx] = nil
+	}
+}
+
+func (i *Info) notifySubscribers(diff MetaDiff) {
+	i.subscribersMu.Lock()
+	fns := make([]func(MetaDiff), 0, len(i.subscribers))
+	for _, fn := range i.subscribers {
+		if fn != nil {
+			fns = append(fns, fn)
+		}
+	}
+	i.subscribersMu.Unlock()
+
+	for _, fn := range fns {
+		fn(diff)
+	}
+}
+
+// startMetaRefresh starts the background refresher when WithMetaRefresh
+// configured a positive Interval, shared by NewInfoWithContext and
+// NewInfoForDex. The refresher always re-fetches the default dex's
+// Meta/SpotMeta (see refreshOnce) regardless of which dex the Info itself
+// was scoped to at construction.
+func (i *Info) startMetaRefresh() {
+	if i.refreshConfig.Interval > 0 {
+		refreshCtx, cancel := context.WithCancel(context.Background())
+		i.refreshCancel = cancel
+		go i.refreshLoop(refreshCtx)
+	}
+}
+
+// StopMetaRefresh stops the background asset-index refresher started by
+// WithMetaRefresh, if any. It is a no-op otherwise, and safe to call more
+// than once.
+func (i *Info) StopMetaRefresh() {
+	if i.refreshCancel != nil {
+		i.refreshCancel()
+	}
+}
+
+// refreshLoop re-fetches Meta/SpotMeta on i.refreshConfig.Interval,
+// swapping the result into i.assets and notifying subscribers of the
+// resulting MetaDiff, until ctx is cancelled (by StopMetaRefresh). A
+// failed fetch backs off with full jitter, capped at MaxBackoff, instead
+// of retrying every Interval during an outage.
+func (i *Info) refreshLoop(ctx context.Context) {
+	interval := i.refreshConfig.Interval
+	maxBackoff := i.refreshConfig.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = defaultMetaRefreshMaxBackoff(interval)
+	}
+
+	failures := 0
+	for {
+		delay := interval
+		if failures > 0 {
+			delay = metaRefreshBackoffDelay(interval, maxBackoff, failures)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+
+		if err := i.refreshOnce(ctx); err != nil {
+			failures++
+			continue
+		}
+		failures = 0
+	}
+}
+
+// refreshOnce fetches Meta/SpotMeta once and, on success, hot-swaps the
+// result into i.assets and notifies subscribers of any resulting
+// MetaDiff.
+func (i *Info) refreshOnce(ctx context.Context) error {
+	meta, err := i.fetchMetaWithContext(ctx, "")
+	if err != nil {
+		return err
+	}
+	spotMeta, err := i.fetchSpotMetaWithContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	diff := i.assets.swap(buildAssetIndex(meta, spotMeta))
+	if !diff.isEmpty() {
+		i.notifySubscribers(diff)
+	}
+	return nil
+}
+
+// metaRefreshBackoffDelay returns a full-jitter exponential backoff delay
+// for the given (1-indexed) consecutive failure count, capped at
+// maxBackoff - the same shape exchange_orders_batch.go's
+// retryBackoffDelay uses for batch order retries.
+func metaRefreshBackoffDelay(base, maxBackoff time.Duration, failures int) time.Duration {
+	backoff := base << uint(failures) //nolint:gosec // failures resets to 0 on success
+	if backoff <= 0 || backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+	return time.Duration(rand.Int63n(int64(backoff) + 1))
+}
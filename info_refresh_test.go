@@ -0,0 +1,97 @@
+package hyperliquid
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssetIndexSwapReportsNewListing(t *testing.T) {
+	idx := buildAssetIndex(
+		&Meta{Universe: []AssetInfo{{Name: "BTC", SzDecimals: 5}}},
+		&SpotMeta{},
+	)
+
+	next := buildAssetIndex(
+		&Meta{Universe: []AssetInfo{{Name: "BTC", SzDecimals: 5}, {Name: "SOL", SzDecimals: 2}}},
+		&SpotMeta{},
+	)
+
+	diff := idx.swap(next)
+
+	assert.Equal(t, []string{"SOL"}, diff.NewCoins)
+	assert.Empty(t, diff.ChangedDecimals)
+
+	asset, ok := idx.coinToAssetGet("SOL")
+	assert.True(t, ok)
+	assert.Equal(t, 1, asset)
+}
+
+func TestAssetIndexSwapReportsChangedDecimals(t *testing.T) {
+	idx := buildAssetIndex(
+		&Meta{Universe: []AssetInfo{{Name: "BTC", SzDecimals: 5}}},
+		&SpotMeta{},
+	)
+
+	next := buildAssetIndex(
+		&Meta{Universe: []AssetInfo{{Name: "BTC", SzDecimals: 4}}},
+		&SpotMeta{},
+	)
+
+	diff := idx.swap(next)
+
+	assert.Empty(t, diff.NewCoins)
+	assert.Equal(t, map[string]int{"BTC": 4}, diff.ChangedDecimals)
+}
+
+func TestAssetIndexSwapIsEmptyWhenNothingChanged(t *testing.T) {
+	meta := &Meta{Universe: []AssetInfo{{Name: "BTC", SzDecimals: 5}}}
+	idx := buildAssetIndex(meta, &SpotMeta{})
+	next := buildAssetIndex(meta, &SpotMeta{})
+
+	diff := idx.swap(next)
+
+	assert.True(t, diff.isEmpty())
+}
+
+func TestInfoSubscribeReceivesDiffOnRefreshOnce(t *testing.T) {
+	info := &Info{assets: buildAssetIndex(
+		&Meta{Universe: []AssetInfo{{Name: "BTC", SzDecimals: 5}}},
+		&SpotMeta{},
+	)}
+
+	var got MetaDiff
+	unsubscribe := info.Subscribe(func(diff MetaDiff) {
+		got = diff
+	})
+	defer unsubscribe()
+
+	next := buildAssetIndex(
+		&Meta{Universe: []AssetInfo{{Name: "BTC", SzDecimals: 5}, {Name: "SOL", SzDecimals: 2}}},
+		&SpotMeta{},
+	)
+	diff := info.assets.swap(next)
+	info.notifySubscribers(diff)
+
+	assert.Equal(t, []string{"SOL"}, got.NewCoins)
+}
+
+func TestInfoSubscribeUnsubscribeStopsNotifications(t *testing.T) {
+	info := &Info{assets: buildAssetIndex(&Meta{}, &SpotMeta{})}
+
+	calls := 0
+	unsubscribe := info.Subscribe(func(diff MetaDiff) {
+		calls++
+	})
+	unsubscribe()
+
+	info.notifySubscribers(MetaDiff{NewCoins: []string{"SOL"}})
+
+	assert.Equal(t, 0, calls)
+}
+
+func TestMetaRefreshBackoffDelayIsCappedAtMaxBackoff(t *testing.T) {
+	delay := metaRefreshBackoffDelay(time.Second, 5*time.Second, 10)
+	assert.LessOrEqual(t, delay, 5*time.Second)
+}
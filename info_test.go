@@ -2,6 +2,7 @@ package hyperliquid
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -944,5 +945,5 @@ func TestPerpDexLimits_RequiresNonEmptyDex(t *testing.T) {
 	// PerpDexLimits should fail with empty dex
 	_, err := info.PerpDexLimits(context.TODO(), "")
 	require.Error(t, err)
-	require.Contains(t, err.Error(), "dex parameter is required")
+	require.True(t, errors.Is(err, ErrInvalidParameter))
 }
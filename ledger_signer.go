@@ -0,0 +1,83 @@
+//go:build ledger
+
+package hyperliquid
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/usbwallet"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// LedgerSigner signs through a Ledger hardware wallet over USB, using
+// go-ethereum's accounts/usbwallet driver. The Ledger Ethereum app has no
+// notion of Hyperliquid's phantom-agent digest, so SignHash reaches the
+// device as an opaque 32-byte blob under accounts.MimetypeTypedData and is
+// blind-signed the same way a RemoteSigner's KMS backend would sign it;
+// the private key itself never leaves the device.
+//
+// Building with LedgerSigner requires the "ledger" build tag and a working
+// libusb/hidapi toolchain, which is why it lives behind one: importing
+// this package should not force a CGO/USB dependency on callers who only
+// need LocalSigner or RemoteSigner.
+type LedgerSigner struct {
+	wallet  accounts.Wallet
+	account accounts.Account
+}
+
+// NewLedgerSigner opens the first Ledger device found over USB and derives
+// the account at derivationPath (e.g. accounts.DefaultBaseDerivationPath).
+func NewLedgerSigner(derivationPath accounts.DerivationPath) (*LedgerSigner, error) {
+	hub, err := usbwallet.NewLedgerHub()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open ledger hub: %w", err)
+	}
+
+	wallets := hub.Wallets()
+	if len(wallets) == 0 {
+		return nil, fmt.Errorf("no ledger device found")
+	}
+
+	wallet := wallets[0]
+	if err := wallet.Open(""); err != nil {
+		return nil, fmt.Errorf("failed to open ledger wallet: %w", err)
+	}
+
+	account, err := wallet.Derive(derivationPath, true)
+	if err != nil {
+		_ = wallet.Close()
+		return nil, fmt.Errorf("failed to derive ledger account: %w", err)
+	}
+
+	return &LedgerSigner{wallet: wallet, account: account}, nil
+}
+
+func (s *LedgerSigner) Address() common.Address {
+	return s.account.Address
+}
+
+func (s *LedgerSigner) SignHash(_ context.Context, hash []byte) (SignatureResult, error) {
+	signature, err := s.wallet.SignData(s.account, accounts.MimetypeTypedData, hash)
+	if err != nil {
+		return SignatureResult{}, fmt.Errorf("ledger refused signature: %w", err)
+	}
+
+	r := new(big.Int).SetBytes(signature[:32])
+	sVal := new(big.Int).SetBytes(signature[32:64])
+	v := int(signature[64]) + 27
+
+	return SignatureResult{
+		R: hexutil.EncodeBig(r),
+		S: hexutil.EncodeBig(sVal),
+		V: v,
+	}, nil
+}
+
+// Close releases the USB connection to the Ledger device.
+func (s *LedgerSigner) Close() error {
+	return s.wallet.Close()
+}
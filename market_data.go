@@ -0,0 +1,424 @@
+package hyperliquid
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// marketDataChangesBuffer bounds each view's Changes() channel. A slow
+// consumer drops the oldest pending event rather than blocking the
+// underlying subscription's delivery.
+const marketDataChangesBuffer = 16
+
+// candleSeriesDefaultCapacity bounds how many candles CandleSeries keeps,
+// and how many it asks for in its initial REST snapshot.
+const candleSeriesDefaultCapacity = 500
+
+// tradeTapeDefaultCapacity bounds how many recent trades TradeTape keeps.
+const tradeTapeDefaultCapacity = 500
+
+// EventKind identifies what changed in an Event delivered on a
+// BookView/CandleSeries/TradeTape's Changes() channel.
+type EventKind int
+
+const (
+	// EventBook means the view's order book was replaced with a new snapshot.
+	EventBook EventKind = iota
+	// EventCandle means a candle was appended or the in-progress candle updated.
+	EventCandle
+	// EventTrade means a new trade print was appended.
+	EventTrade
+)
+
+// Event is delivered on a view's Changes() channel every time it updates.
+// It carries no payload; callers read the view itself (BestBidAsk, Recent,
+// ...) to see the new state, the same snapshot-then-read pattern
+// Changes() is modelled after.
+type Event struct {
+	Kind EventKind
+	Coin string
+}
+
+// MarketData is a façade over Info and WebsocketClient that gives each
+// (coin, channel) a self-healing local view instead of making callers wire
+// up REST snapshot, WS subscription, and gap recovery by hand: Book,
+// Candles, and Trades each lazily open their subscription on first use and
+// fetch a REST snapshot where one exists. Candles resyncs from REST on a
+// detected sequence gap and Book resubscribes on a stalled feed; Trades
+// has no gap recovery. Views are cached per (coin[, interval]), so
+// repeated calls return the same live view rather than resubscribing.
+type MarketData struct {
+	info *Info
+	ws   *WebsocketClient
+
+	mu      sync.Mutex
+	books   map[string]*BookView
+	candles map[candleSeriesKey]*CandleSeries
+	trades  map[string]*TradeTape
+}
+
+type candleSeriesKey struct {
+	coin     string
+	interval string
+}
+
+// NewMarketData wraps info and ws into a MarketData façade. ws must already
+// be connected (or connecting via ConnectWithRetry); MarketData only
+// subscribes on top of it, it does not dial.
+func NewMarketData(info *Info, ws *WebsocketClient) *MarketData {
+	return &MarketData{
+		info:    info,
+		ws:      ws,
+		books:   make(map[string]*BookView),
+		candles: make(map[candleSeriesKey]*CandleSeries),
+		trades:  make(map[string]*TradeTape),
+	}
+}
+
+// Book returns coin's locally maintained order book, subscribing on first
+// use. Subsequent calls for the same coin return the same view.
+func (md *MarketData) Book(coin string) (*BookView, error) {
+	md.mu.Lock()
+	defer md.mu.Unlock()
+
+	if v, ok := md.books[coin]; ok {
+		return v, nil
+	}
+
+	ob, err := md.ws.SubscribeOrderBook(coin)
+	if err != nil {
+		return nil, fmt.Errorf("market data: subscribe book %s: %w", coin, err)
+	}
+
+	v := newBookView(ob, coin)
+	md.books[coin] = v
+	return v, nil
+}
+
+// Candles returns coin's locally maintained candle series for interval,
+// subscribing and fetching the initial REST snapshot on first use.
+// Subsequent calls for the same (coin, interval) return the same view.
+func (md *MarketData) Candles(coin, interval string) (*CandleSeries, error) {
+	key := candleSeriesKey{coin: coin, interval: interval}
+
+	md.mu.Lock()
+	if v, ok := md.candles[key]; ok {
+		md.mu.Unlock()
+		return v, nil
+	}
+	md.mu.Unlock()
+
+	cs, err := newCandleSeries(md.info, md.ws, coin, interval)
+	if err != nil {
+		return nil, fmt.Errorf("market data: subscribe candles %s %s: %w", coin, interval, err)
+	}
+
+	md.mu.Lock()
+	defer md.mu.Unlock()
+	if v, ok := md.candles[key]; ok {
+		cs.Close()
+		return v, nil
+	}
+	md.candles[key] = cs
+	return cs, nil
+}
+
+// Trades returns coin's live trade tape, subscribing on first use.
+// Subsequent calls for the same coin return the same view.
+func (md *MarketData) Trades(coin string) (*TradeTape, error) {
+	md.mu.Lock()
+	defer md.mu.Unlock()
+
+	if v, ok := md.trades[coin]; ok {
+		return v, nil
+	}
+
+	tt, err := newTradeTape(md.ws, coin)
+	if err != nil {
+		return nil, fmt.Errorf("market data: subscribe trades %s: %w", coin, err)
+	}
+
+	md.trades[coin] = tt
+	return tt, nil
+}
+
+// eventEmitter fans update notifications into a bounded channel, dropping
+// the oldest pending event when a consumer falls behind rather than
+// blocking the caller delivering the update.
+type eventEmitter struct {
+	mu sync.Mutex
+	ch chan Event
+}
+
+func newEventEmitter() *eventEmitter {
+	return &eventEmitter{ch: make(chan Event, marketDataChangesBuffer)}
+}
+
+func (e *eventEmitter) emit(ev Event) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	select {
+	case e.ch <- ev:
+		return
+	default:
+	}
+
+	select {
+	case <-e.ch:
+	default:
+	}
+	select {
+	case e.ch <- ev:
+	default:
+	}
+}
+
+// BookView is MarketData's Book view: an OrderBook (chunk1-2's local L2
+// book maintainer, which already resubscribes on a stalled feed since
+// l2Book messages are full snapshots rather than diffs) plus a Changes()
+// stream fired on every applied snapshot.
+type BookView struct {
+	*OrderBook
+	coin    string
+	emitter *eventEmitter
+}
+
+func newBookView(ob *OrderBook, coin string) *BookView {
+	v := &BookView{OrderBook: ob, coin: coin, emitter: newEventEmitter()}
+	ob.Diff = func(bids, asks []Level) {
+		v.emitter.emit(Event{Kind: EventBook, Coin: coin})
+	}
+	return v
+}
+
+// Changes returns a channel that receives an Event every time the book is
+// updated with a new snapshot.
+func (v *BookView) Changes() <-chan Event {
+	return v.emitter.ch
+}
+
+// CandleSeries is MarketData's Candles view: coin's interval candle
+// history, fetched once via Info.CandlesSnapshot and kept current by
+// ws.SubscribeCandleChan, upserting the in-progress candle by TimeOpen
+// until it closes rather than appending a duplicate. It resyncs by
+// re-fetching the REST snapshot whenever SubscribeCandleChan reports a
+// sequence gap.
+type CandleSeries struct {
+	info     *Info
+	ws       *WebsocketClient
+	coin     string
+	interval string
+
+	mu      sync.Mutex
+	candles []Candle
+
+	emitter *eventEmitter
+	cancel  func()
+}
+
+func newCandleSeries(info *Info, ws *WebsocketClient, coin, interval string) (*CandleSeries, error) {
+	cs := &CandleSeries{
+		info:     info,
+		ws:       ws,
+		coin:     coin,
+		interval: interval,
+		emitter:  newEventEmitter(),
+	}
+
+	if err := cs.resync(); err != nil {
+		return nil, err
+	}
+
+	ch, cancel, err := ws.SubscribeCandleChan(coin, interval, TypedSubscribeOpts{
+		OnSequenceGap: func(SequenceGap) { _ = cs.resync() },
+	})
+	if err != nil {
+		return nil, err
+	}
+	cs.cancel = cancel
+
+	go cs.run(ch)
+	return cs, nil
+}
+
+func (cs *CandleSeries) resync() error {
+	now := time.Now().UnixMilli()
+	lookback := intervalMillis(cs.interval) * candleSeriesDefaultCapacity
+	if lookback <= 0 {
+		lookback = candleSeriesDefaultCapacity * int64(time.Minute/time.Millisecond)
+	}
+
+	snapshot, err := cs.info.CandlesSnapshotWithContext(context.Background(), cs.coin, cs.interval, now-lookback, now)
+	if err != nil {
+		return fmt.Errorf("candle series: resync %s %s: %w", cs.coin, cs.interval, err)
+	}
+
+	cs.mu.Lock()
+	cs.candles = snapshot
+	cs.mu.Unlock()
+
+	cs.emitter.emit(Event{Kind: EventCandle, Coin: cs.coin})
+	return nil
+}
+
+func (cs *CandleSeries) run(ch <-chan Candle) {
+	for candle := range ch {
+		cs.mu.Lock()
+		cs.candles = upsertCandle(cs.candles, candle, candleSeriesDefaultCapacity)
+		cs.mu.Unlock()
+		cs.emitter.emit(Event{Kind: EventCandle, Coin: cs.coin})
+	}
+}
+
+// upsertCandle replaces candles' last entry when candle shares its
+// TimeOpen (the exchange resends the currently-forming candle repeatedly
+// until it closes), otherwise appends it, keeping at most maxLen entries.
+func upsertCandle(candles []Candle, candle Candle, maxLen int) []Candle {
+	if n := len(candles); n > 0 && candles[n-1].TimeOpen == candle.TimeOpen {
+		candles[n-1] = candle
+	} else {
+		candles = append(candles, candle)
+	}
+
+	if len(candles) > maxLen {
+		candles = candles[len(candles)-maxLen:]
+	}
+	return candles
+}
+
+// Recent returns up to n of the most recently seen candles, oldest first.
+func (cs *CandleSeries) Recent(n int) []Candle {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	if n > len(cs.candles) {
+		n = len(cs.candles)
+	}
+	out := make([]Candle, n)
+	copy(out, cs.candles[len(cs.candles)-n:])
+	return out
+}
+
+// Changes returns a channel that receives an Event every time a candle is
+// appended or the in-progress candle is updated.
+func (cs *CandleSeries) Changes() <-chan Event {
+	return cs.emitter.ch
+}
+
+// Close unsubscribes the series from live candle updates.
+func (cs *CandleSeries) Close() {
+	if cs.cancel != nil {
+		cs.cancel()
+	}
+}
+
+// intervalMillis returns interval's duration in milliseconds, or 0 if
+// interval isn't one of Hyperliquid's documented candle intervals.
+func intervalMillis(interval string) int64 {
+	const (
+		minute = int64(time.Minute / time.Millisecond)
+		hour   = 60 * minute
+		day    = 24 * hour
+	)
+
+	switch interval {
+	case "1m":
+		return minute
+	case "3m":
+		return 3 * minute
+	case "5m":
+		return 5 * minute
+	case "15m":
+		return 15 * minute
+	case "30m":
+		return 30 * minute
+	case "1h":
+		return hour
+	case "2h":
+		return 2 * hour
+	case "4h":
+		return 4 * hour
+	case "8h":
+		return 8 * hour
+	case "12h":
+		return 12 * hour
+	case "1d":
+		return day
+	case "3d":
+		return 3 * day
+	case "1w":
+		return 7 * day
+	case "1M":
+		return 30 * day
+	default:
+		return 0
+	}
+}
+
+// TradeTape is MarketData's Trades view: a coin's most recent live trade
+// prints. Hyperliquid's info API has no recent-trades REST endpoint, so
+// unlike BookView/CandleSeries there is no initial snapshot to layer WS
+// updates on top of — the tape starts empty and fills in as trades stream.
+type TradeTape struct {
+	coin string
+
+	mu     sync.Mutex
+	trades []Trade
+
+	emitter *eventEmitter
+	cancel  func()
+}
+
+func newTradeTape(ws *WebsocketClient, coin string) (*TradeTape, error) {
+	tt := &TradeTape{coin: coin, emitter: newEventEmitter()}
+
+	ch, cancel, err := ws.SubscribeTradesChan(coin, TypedSubscribeOpts{})
+	if err != nil {
+		return nil, err
+	}
+	tt.cancel = cancel
+
+	go tt.run(ch)
+	return tt, nil
+}
+
+func (tt *TradeTape) run(ch <-chan Trade) {
+	for trade := range ch {
+		tt.mu.Lock()
+		tt.trades = append(tt.trades, trade)
+		if len(tt.trades) > tradeTapeDefaultCapacity {
+			tt.trades = tt.trades[len(tt.trades)-tradeTapeDefaultCapacity:]
+		}
+		tt.mu.Unlock()
+		tt.emitter.emit(Event{Kind: EventTrade, Coin: tt.coin})
+	}
+}
+
+// Recent returns up to n of the most recently seen trades, oldest first.
+func (tt *TradeTape) Recent(n int) []Trade {
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+
+	if n > len(tt.trades) {
+		n = len(tt.trades)
+	}
+	out := make([]Trade, n)
+	copy(out, tt.trades[len(tt.trades)-n:])
+	return out
+}
+
+// Changes returns a channel that receives an Event every time a new trade
+// is appended.
+func (tt *TradeTape) Changes() <-chan Event {
+	return tt.emitter.ch
+}
+
+// Close unsubscribes the tape from live trade updates.
+func (tt *TradeTape) Close() {
+	if tt.cancel != nil {
+		tt.cancel()
+	}
+}
@@ -0,0 +1,35 @@
+package hyperliquid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpsertCandleReplacesInProgressCandle(t *testing.T) {
+	candles := []Candle{{TimeOpen: 1, Close: "10"}}
+
+	candles = upsertCandle(candles, Candle{TimeOpen: 1, Close: "11"}, 500)
+	assert.Equal(t, []Candle{{TimeOpen: 1, Close: "11"}}, candles)
+
+	candles = upsertCandle(candles, Candle{TimeOpen: 2, Close: "12"}, 500)
+	assert.Equal(t, []Candle{{TimeOpen: 1, Close: "11"}, {TimeOpen: 2, Close: "12"}}, candles)
+}
+
+func TestUpsertCandleCapsLength(t *testing.T) {
+	var candles []Candle
+	for i := int64(0); i < 5; i++ {
+		candles = upsertCandle(candles, Candle{TimeOpen: i}, 3)
+	}
+
+	assert.Len(t, candles, 3)
+	assert.Equal(t, int64(2), candles[0].TimeOpen)
+	assert.Equal(t, int64(4), candles[2].TimeOpen)
+}
+
+func TestIntervalMillis(t *testing.T) {
+	assert.Equal(t, int64(60_000), intervalMillis("1m"))
+	assert.Equal(t, int64(3_600_000), intervalMillis("1h"))
+	assert.Equal(t, int64(86_400_000), intervalMillis("1d"))
+	assert.Equal(t, int64(0), intervalMillis("bogus"))
+}
@@ -0,0 +1,296 @@
+package hyperliquid
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// MetaCacheConfig configures Info.WithMetaCache.
+type MetaCacheConfig struct {
+	// TTL is how long a memoized Meta/SpotMeta/MetaAndAssetCtxs/
+	// SpotMetaAndAssetCtxs/PerpDexs result stays fresh before the next
+	// caller triggers a refetch. Zero means every call refetches, which
+	// still gets singleflight deduplication but no memoization.
+	TTL time.Duration
+	// PrefetchInterval, if nonzero, runs a background goroutine that
+	// refreshes every memoized endpoint (plus MetaAndAssetCtxs for the
+	// default dex and every dex PerpDexs last reported) on this cadence, so
+	// cold-start latency never resurfaces after the first TTL expiry.
+	PrefetchInterval time.Duration
+}
+
+// MetaCacheStats reports a MetaCache's activity for observability.
+type MetaCacheStats struct {
+	Hits      int64
+	Misses    int64
+	Refreshes int64
+	InFlight  int64
+}
+
+// metaCacheEntry is one memoized endpoint result, keyed by cache key.
+type metaCacheEntry struct {
+	value     any
+	err       error
+	expiresAt time.Time
+}
+
+// metaCacheCall is an in-flight fetch other callers for the same key wait
+// on instead of issuing their own request (singleflight-style dedup).
+type metaCacheCall struct {
+	done  chan struct{}
+	value any
+	err   error
+}
+
+// MetaCache memoizes Info's meta-shaped endpoints (Meta, SpotMeta,
+// MetaAndAssetCtxs, MetaAndAssetCtxsForDex, SpotMetaAndAssetCtxs, PerpDexs)
+// behind a TTL, deduplicating concurrent callers for the same key onto one
+// HTTP round trip. Attach one to an Info with Info.WithMetaCache; the zero
+// value is not usable.
+type MetaCache struct {
+	info *Info
+	ttl  time.Duration
+
+	mu      sync.Mutex
+	entries map[string]*metaCacheEntry
+	calls   map[string]*metaCacheCall
+
+	hits, misses, refreshes, inflight atomic.Int64
+
+	done chan struct{}
+}
+
+const (
+	metaCacheKeyMeta     = "meta"
+	metaCacheKeySpotMeta = "spotMeta"
+	metaCacheKeySpotCtxs = "spotMetaAndAssetCtxs"
+	metaCacheKeyPerpDexs = "perpDexs"
+)
+
+// metaCacheKeyAssetCtxs is the cache key for a single dex's
+// MetaAndAssetCtxs ("" is the default dex), matching AggregatedMeta's
+// qualifiedAssetName convention of treating "" as the default namespace.
+func metaCacheKeyAssetCtxs(dex string) string {
+	return "metaAndAssetCtxs:" + dex
+}
+
+func newMetaCache(info *Info, cfg MetaCacheConfig) *MetaCache {
+	mc := &MetaCache{
+		info:    info,
+		ttl:     cfg.TTL,
+		entries: make(map[string]*metaCacheEntry),
+		calls:   make(map[string]*metaCacheCall),
+		done:    make(chan struct{}),
+	}
+	if cfg.PrefetchInterval > 0 {
+		go mc.prefetchLoop(cfg.PrefetchInterval)
+	}
+	return mc
+}
+
+// WithMetaCache returns a copy of i whose Meta, SpotMeta, MetaAndAssetCtxs,
+// MetaAndAssetCtxsForDex, SpotMetaAndAssetCtxs, and PerpDexs calls are
+// memoized per cfg, so concurrent callers share one HTTP round trip and
+// repeat calls within cfg.TTL cost nothing. i itself is left unmodified.
+func (i *Info) WithMetaCache(cfg MetaCacheConfig) *Info {
+	wrapped := *i
+	wrapped.metaCache = newMetaCache(&wrapped, cfg)
+	return &wrapped
+}
+
+// InvalidateMeta discards the cached MetaAndAssetCtxs for dex (the default
+// dex for ""), so the next call refetches instead of serving a stale
+// value. It is a no-op when i has no MetaCache attached.
+func (i *Info) InvalidateMeta(dex string) {
+	if i.metaCache == nil {
+		return
+	}
+	i.metaCache.invalidate(dex)
+}
+
+// MetaCacheStats reports the attached MetaCache's hit/miss/refresh/
+// in-flight counters, or the zero value when i has no MetaCache attached.
+func (i *Info) MetaCacheStats() MetaCacheStats {
+	if i.metaCache == nil {
+		return MetaCacheStats{}
+	}
+	return i.metaCache.stats()
+}
+
+// CloseMetaCache stops the attached MetaCache's background prefetch
+// goroutine, if any. It is a no-op when i has no MetaCache attached.
+func (i *Info) CloseMetaCache() {
+	if i.metaCache == nil {
+		return
+	}
+	i.metaCache.Close()
+}
+
+// get returns key's memoized value, fetching it through fetch on a miss or
+// expiry. Concurrent callers racing the same miss share the one in-flight
+// fetch rather than each issuing their own.
+func (mc *MetaCache) get(ctx context.Context, key string, fetch func() (any, error)) (any, error) {
+	mc.mu.Lock()
+	if entry, ok := mc.entries[key]; ok && time.Now().Before(entry.expiresAt) {
+		mc.mu.Unlock()
+		mc.hits.Add(1)
+		return entry.value, entry.err
+	}
+	if call, ok := mc.calls[key]; ok {
+		mc.mu.Unlock()
+		<-call.done
+		return call.value, call.err
+	}
+
+	mc.misses.Add(1)
+	call := &metaCacheCall{done: make(chan struct{})}
+	mc.calls[key] = call
+	mc.inflight.Add(1)
+	mc.mu.Unlock()
+
+	value, err := fetch()
+
+	mc.mu.Lock()
+	delete(mc.calls, key)
+	mc.entries[key] = &metaCacheEntry{value: value, err: err, expiresAt: time.Now().Add(mc.ttl)}
+	mc.mu.Unlock()
+
+	mc.inflight.Add(-1)
+	call.value, call.err = value, err
+	close(call.done)
+
+	return value, err
+}
+
+// refresh unconditionally fetches key and stores the result, bypassing any
+// cached value. The background prefetch loop uses this to keep entries
+// warm ahead of expiry instead of waiting for the next caller to pay for a
+// refetch.
+func (mc *MetaCache) refresh(key string, fetch func() (any, error)) (any, error) {
+	value, err := fetch()
+	mc.mu.Lock()
+	mc.entries[key] = &metaCacheEntry{value: value, err: err, expiresAt: time.Now().Add(mc.ttl)}
+	mc.mu.Unlock()
+	mc.refreshes.Add(1)
+	return value, err
+}
+
+// invalidate discards the cached MetaAndAssetCtxs entry for dex.
+func (mc *MetaCache) invalidate(dex string) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	delete(mc.entries, metaCacheKeyAssetCtxs(dex))
+}
+
+func (mc *MetaCache) stats() MetaCacheStats {
+	return MetaCacheStats{
+		Hits:      mc.hits.Load(),
+		Misses:    mc.misses.Load(),
+		Refreshes: mc.refreshes.Load(),
+		InFlight:  mc.inflight.Load(),
+	}
+}
+
+// Close stops the background prefetch goroutine, if one was started. Close
+// is idempotent.
+func (mc *MetaCache) Close() {
+	select {
+	case <-mc.done:
+	default:
+		close(mc.done)
+	}
+}
+
+// prefetchedDexs returns the dexes prefetchOnce should warm: every
+// non-default dex PerpDexs last reported, plus the default dex, so a dex
+// nobody has queried yet via MetaAndAssetCtxsForDex still gets warmed once
+// PerpDexs has seen it.
+func (mc *MetaCache) prefetchedDexs() []string {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	entry, ok := mc.entries[metaCacheKeyPerpDexs]
+	if !ok || entry.err != nil {
+		return []string{""}
+	}
+	dexs, ok := entry.value.([]string)
+	if !ok {
+		return []string{""}
+	}
+	seen := make(map[string]bool, len(dexs)+1)
+	out := make([]string, 0, len(dexs)+1)
+	for _, dex := range append([]string{""}, dexs...) {
+		if seen[dex] {
+			continue
+		}
+		seen[dex] = true
+		out = append(out, dex)
+	}
+	return out
+}
+
+// prefetchLoop refreshes every memoized endpoint every interval until
+// Close is called.
+func (mc *MetaCache) prefetchLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-mc.done:
+			return
+		case <-ticker.C:
+			mc.prefetchOnce()
+		}
+	}
+}
+
+// prefetchOnce refreshes Meta, SpotMeta, SpotMetaAndAssetCtxs, PerpDexs,
+// and MetaAndAssetCtxs for every dex PerpDexs last reported.
+func (mc *MetaCache) prefetchOnce() {
+	ctx := context.Background()
+
+	mc.refresh(metaCacheKeyMeta, func() (any, error) { return mc.info.fetchMetaWithContext(ctx, "") })
+	mc.refresh(metaCacheKeySpotMeta, func() (any, error) { return mc.info.fetchSpotMetaWithContext(ctx) })
+	mc.refresh(metaCacheKeySpotCtxs, func() (any, error) { return mc.info.fetchSpotMetaAndAssetCtxsWithContext(ctx) })
+	mc.refresh(metaCacheKeyPerpDexs, func() (any, error) { return mc.info.fetchPerpDexsWithContext(ctx) })
+
+	for _, dex := range mc.prefetchedDexs() {
+		dex := dex
+		mc.refresh(metaCacheKeyAssetCtxs(dex), func() (any, error) {
+			if dex == "" {
+				return mc.info.fetchMetaAndAssetCtxsWithContext(ctx)
+			}
+			return mc.info.fetchMetaAndAssetCtxsForDexWithContext(ctx, dex)
+		})
+	}
+}
+
+// UpdateAssetCtx patches the cached MetaAndAssetCtxs entry for dex so the
+// AssetCtx belonging to coin reflects ctx, without a full refetch. Wire
+// this into a WebsocketClient.ActiveAssetCtx or WebData2 subscription
+// callback so ticking mark-price/funding updates keep the cache fresh
+// between TTL-driven refreshes. It reports whether dex has a cached entry
+// with a matching coin to patch.
+func (mc *MetaCache) UpdateAssetCtx(dex, coin string, ctx AssetCtx) bool {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	entry, ok := mc.entries[metaCacheKeyAssetCtxs(dex)]
+	if !ok || entry.err != nil {
+		return false
+	}
+	mac, ok := entry.value.(*MetaAndAssetCtxs)
+	if !ok {
+		return false
+	}
+
+	for idx, assetInfo := range mac.Meta.Universe {
+		if assetInfo.Name != coin || idx >= len(mac.Ctxs) {
+			continue
+		}
+		mac.Ctxs[idx] = ctx
+		return true
+	}
+	return false
+}
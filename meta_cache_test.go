@@ -0,0 +1,117 @@
+package hyperliquid
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetaCacheGetMemoizesWithinTTL(t *testing.T) {
+	mc := newMetaCache(&Info{}, MetaCacheConfig{TTL: time.Minute})
+	defer mc.Close()
+
+	var calls atomic.Int64
+	fetch := func() (any, error) {
+		calls.Add(1)
+		return "value", nil
+	}
+
+	v1, err := mc.get(context.Background(), "k", fetch)
+	require.NoError(t, err)
+	v2, err := mc.get(context.Background(), "k", fetch)
+	require.NoError(t, err)
+
+	assert.Equal(t, "value", v1)
+	assert.Equal(t, "value", v2)
+	assert.Equal(t, int64(1), calls.Load())
+
+	stats := mc.stats()
+	assert.Equal(t, int64(1), stats.Misses)
+	assert.Equal(t, int64(1), stats.Hits)
+}
+
+func TestMetaCacheGetRefetchesAfterTTLExpiry(t *testing.T) {
+	mc := newMetaCache(&Info{}, MetaCacheConfig{TTL: time.Microsecond})
+	defer mc.Close()
+
+	var calls atomic.Int64
+	fetch := func() (any, error) {
+		calls.Add(1)
+		return "value", nil
+	}
+
+	_, err := mc.get(context.Background(), "k", fetch)
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond)
+	_, err = mc.get(context.Background(), "k", fetch)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(2), calls.Load())
+}
+
+func TestMetaCacheGetDeduplicatesConcurrentMisses(t *testing.T) {
+	mc := newMetaCache(&Info{}, MetaCacheConfig{TTL: time.Minute})
+	defer mc.Close()
+
+	var calls atomic.Int64
+	release := make(chan struct{})
+	fetch := func() (any, error) {
+		calls.Add(1)
+		<-release
+		return "value", nil
+	}
+
+	var wg sync.WaitGroup
+	for n := 0; n < 5; n++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := mc.get(context.Background(), "k", fetch)
+			assert.NoError(t, err)
+			assert.Equal(t, "value", v)
+		}()
+	}
+
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int64(1), calls.Load())
+}
+
+func TestMetaCacheInvalidateIsDexScoped(t *testing.T) {
+	mc := newMetaCache(&Info{}, MetaCacheConfig{TTL: time.Minute})
+	defer mc.Close()
+
+	mc.entries[metaCacheKeyAssetCtxs("")] = &metaCacheEntry{value: "default", expiresAt: time.Now().Add(time.Minute)}
+	mc.entries[metaCacheKeyAssetCtxs("xyz")] = &metaCacheEntry{value: "xyz", expiresAt: time.Now().Add(time.Minute)}
+
+	mc.invalidate("xyz")
+
+	_, hasDefault := mc.entries[metaCacheKeyAssetCtxs("")]
+	_, hasXyz := mc.entries[metaCacheKeyAssetCtxs("xyz")]
+	assert.True(t, hasDefault)
+	assert.False(t, hasXyz)
+}
+
+func TestMetaCacheUpdateAssetCtxPatchesMatchingCoin(t *testing.T) {
+	mc := newMetaCache(&Info{}, MetaCacheConfig{TTL: time.Minute})
+	defer mc.Close()
+
+	mac := &MetaAndAssetCtxs{
+		Meta: Meta{Universe: []AssetInfo{{Name: "BTC"}, {Name: "ETH"}}},
+		Ctxs: []AssetCtx{{}, {}},
+	}
+	mc.entries[metaCacheKeyAssetCtxs("")] = &metaCacheEntry{value: mac, expiresAt: time.Now().Add(time.Minute)}
+
+	updated := AssetCtx{MarkPx: "123.4"}
+	ok := mc.UpdateAssetCtx("", "ETH", updated)
+
+	assert.True(t, ok)
+	assert.Equal(t, updated, mac.Ctxs[1])
+	assert.False(t, mc.UpdateAssetCtx("", "SOL", updated))
+}
@@ -0,0 +1,63 @@
+package hyperliquid
+
+import "time"
+
+// MetricsSink receives Info/Exchange RPC instrumentation: request counts
+// by endpoint and outcome, latency, retries (e.g. a MethodPOSTWithFallback
+// GET retry), rate-limit hits, and the builder-dex gauges
+// PerpDexLimits/PerpDexStatus/PerpDeployAuctionStatus populate whenever
+// they're polled. Implement this directly to plug in OpenTelemetry or
+// statsd, or use NewPrometheusMetricsSink for Prometheus. Attach one with
+// WithMetrics/ExchangeOptMetrics; absent that, Info and Exchange use a
+// no-op sink with zero overhead.
+type MetricsSink interface {
+	// IncRequest records one RPC to endpoint completing with outcome,
+	// "ok" or "error".
+	IncRequest(endpoint, outcome string)
+	// ObserveLatency records how long one RPC to endpoint took.
+	ObserveLatency(endpoint string, d time.Duration)
+	// IncRetry records one retried RPC to endpoint.
+	IncRetry(endpoint string)
+	// IncRateLimitHit records one RPC to endpoint that hit Hyperliquid's
+	// rate limit.
+	IncRateLimitHit(endpoint string)
+	// SetPerpDexTotalNetDeposit sets dex's current TotalNetDeposit, as
+	// reported by PerpDexStatus (hyperliquid_perp_dex_total_net_deposit).
+	SetPerpDexTotalNetDeposit(dex string, value float64)
+	// SetPerpDexOiCap sets dex's current TotalOiCap, as reported by
+	// PerpDexLimits (hyperliquid_perp_dex_oi_cap).
+	SetPerpDexOiCap(dex string, value float64)
+	// SetPerpAuctionCurrentGas sets the venue-wide deploy auction's
+	// CurrentGas, as reported by PerpDeployAuctionStatus
+	// (hyperliquid_perp_auction_current_gas).
+	SetPerpAuctionCurrentGas(value float64)
+}
+
+// noopMetricsSink is the zero-overhead default MetricsSink, used whenever
+// WithMetrics/ExchangeOptMetrics is never called.
+type noopMetricsSink struct{}
+
+func (noopMetricsSink) IncRequest(string, string)                 {}
+func (noopMetricsSink) ObserveLatency(string, time.Duration)      {}
+func (noopMetricsSink) IncRetry(string)                           {}
+func (noopMetricsSink) IncRateLimitHit(string)                    {}
+func (noopMetricsSink) SetPerpDexTotalNetDeposit(string, float64) {}
+func (noopMetricsSink) SetPerpDexOiCap(string, float64)           {}
+func (noopMetricsSink) SetPerpAuctionCurrentGas(float64)          {}
+
+// endpointNameFromPayload extracts payload's "type" field for metrics
+// labeling, since every Info request in this package sends a
+// map[string]any payload with a "type" key naming the RPC. It falls back
+// to path for any payload that isn't that shape, which includes Exchange's
+// action/nonce/signature envelope.
+func endpointNameFromPayload(payload any, path string) string {
+	m, ok := payload.(map[string]any)
+	if !ok {
+		return path
+	}
+	t, ok := m["type"].(string)
+	if !ok || t == "" {
+		return path
+	}
+	return t
+}
@@ -0,0 +1,96 @@
+package hyperliquid
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// PrometheusMetricsSink implements MetricsSink by registering collectors
+// against a prometheus.Registerer, for WithMetrics/ExchangeOptMetrics
+// callers who want Prometheus rather than a custom MetricsSink.
+type PrometheusMetricsSink struct {
+	requests       *prometheus.CounterVec
+	latency        *prometheus.HistogramVec
+	retries        *prometheus.CounterVec
+	rateLimitHits  *prometheus.CounterVec
+	perpDexDeposit *prometheus.GaugeVec
+	perpDexOiCap   *prometheus.GaugeVec
+	perpAuctionGas prometheus.Gauge
+}
+
+// NewPrometheusMetricsSink builds a PrometheusMetricsSink and registers
+// its collectors against reg (pass prometheus.DefaultRegisterer to use the
+// global registry).
+func NewPrometheusMetricsSink(reg prometheus.Registerer) *PrometheusMetricsSink {
+	s := &PrometheusMetricsSink{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "hyperliquid_requests_total",
+			Help: "Total Info/Exchange RPCs, by endpoint and outcome.",
+		}, []string{"endpoint", "outcome"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name: "hyperliquid_request_duration_seconds",
+			Help: "Info/Exchange RPC latency, by endpoint.",
+		}, []string{"endpoint"}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "hyperliquid_request_retries_total",
+			Help: "Total retried RPCs (e.g. a MethodPOSTWithFallback GET retry), by endpoint.",
+		}, []string{"endpoint"}),
+		rateLimitHits: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "hyperliquid_rate_limit_hits_total",
+			Help: "Total RPCs that hit Hyperliquid's rate limit, by endpoint.",
+		}, []string{"endpoint"}),
+		perpDexDeposit: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "hyperliquid_perp_dex_total_net_deposit",
+			Help: "PerpDexStatus's TotalNetDeposit, by dex.",
+		}, []string{"dex"}),
+		perpDexOiCap: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "hyperliquid_perp_dex_oi_cap",
+			Help: "PerpDexLimits' TotalOiCap, by dex.",
+		}, []string{"dex"}),
+		perpAuctionGas: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "hyperliquid_perp_auction_current_gas",
+			Help: "PerpDeployAuctionStatus's CurrentGas.",
+		}),
+	}
+
+	reg.MustRegister(
+		s.requests,
+		s.latency,
+		s.retries,
+		s.rateLimitHits,
+		s.perpDexDeposit,
+		s.perpDexOiCap,
+		s.perpAuctionGas,
+	)
+
+	return s
+}
+
+func (s *PrometheusMetricsSink) IncRequest(endpoint, outcome string) {
+	s.requests.WithLabelValues(endpoint, outcome).Inc()
+}
+
+func (s *PrometheusMetricsSink) ObserveLatency(endpoint string, d time.Duration) {
+	s.latency.WithLabelValues(endpoint).Observe(d.Seconds())
+}
+
+func (s *PrometheusMetricsSink) IncRetry(endpoint string) {
+	s.retries.WithLabelValues(endpoint).Inc()
+}
+
+func (s *PrometheusMetricsSink) IncRateLimitHit(endpoint string) {
+	s.rateLimitHits.WithLabelValues(endpoint).Inc()
+}
+
+func (s *PrometheusMetricsSink) SetPerpDexTotalNetDeposit(dex string, value float64) {
+	s.perpDexDeposit.WithLabelValues(dex).Set(value)
+}
+
+func (s *PrometheusMetricsSink) SetPerpDexOiCap(dex string, value float64) {
+	s.perpDexOiCap.WithLabelValues(dex).Set(value)
+}
+
+func (s *PrometheusMetricsSink) SetPerpAuctionCurrentGas(value float64) {
+	s.perpAuctionGas.Set(value)
+}
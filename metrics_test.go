@@ -0,0 +1,123 @@
+package hyperliquid
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeMetricsSink records every call for assertion, standing in for a
+// MetricsSink implementation in tests the way a hand-rolled fake stands in
+// for an interface elsewhere in this package's test suite.
+type fakeMetricsSink struct {
+	mu            sync.Mutex
+	requests      []string // "endpoint:outcome"
+	retries       []string
+	rateLimitHits []string
+	latencies     int
+}
+
+func (f *fakeMetricsSink) IncRequest(endpoint, outcome string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests = append(f.requests, endpoint+":"+outcome)
+}
+
+func (f *fakeMetricsSink) ObserveLatency(string, time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.latencies++
+}
+
+func (f *fakeMetricsSink) IncRetry(endpoint string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.retries = append(f.retries, endpoint)
+}
+
+func (f *fakeMetricsSink) IncRateLimitHit(endpoint string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.rateLimitHits = append(f.rateLimitHits, endpoint)
+}
+
+func (f *fakeMetricsSink) SetPerpDexTotalNetDeposit(string, float64) {}
+func (f *fakeMetricsSink) SetPerpDexOiCap(string, float64)           {}
+func (f *fakeMetricsSink) SetPerpAuctionCurrentGas(float64)          {}
+
+func TestNoopMetricsSinkDoesNotPanic(t *testing.T) {
+	var sink MetricsSink = noopMetricsSink{}
+	sink.IncRequest("meta", "ok")
+	sink.ObserveLatency("meta", time.Millisecond)
+	sink.IncRetry("meta")
+	sink.IncRateLimitHit("meta")
+	sink.SetPerpDexTotalNetDeposit("test", 1)
+	sink.SetPerpDexOiCap("test", 1)
+	sink.SetPerpAuctionCurrentGas(1)
+}
+
+func TestClientPostRecordsRequestAndLatencyMetrics(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer srv.Close()
+
+	sink := &fakeMetricsSink{}
+	c := newClient(srv.URL, ClientOptMetrics(sink))
+
+	_, err := c.post(context.Background(), "/info", map[string]any{"type": "meta"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"meta:ok"}, sink.requests)
+	assert.Equal(t, 1, sink.latencies)
+	assert.Empty(t, sink.retries)
+}
+
+func TestClientPostRecordsRetryMetricOnFallback(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer srv.Close()
+
+	sink := &fakeMetricsSink{}
+	c := newClient(srv.URL, ClientOptRequestMethod(MethodPOSTWithFallback), ClientOptMetrics(sink))
+
+	_, err := c.post(context.Background(), "/info", map[string]any{"type": "perpDexStatus"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"perpDexStatus"}, sink.retries)
+	assert.Equal(t, []string{"perpDexStatus:ok"}, sink.requests)
+}
+
+func TestClientPostRecordsRateLimitHitMetric(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = w.Write([]byte(`{"code":429,"msg":"rate limited"}`))
+	}))
+	defer srv.Close()
+
+	sink := &fakeMetricsSink{}
+	c := newClient(srv.URL, ClientOptMetrics(sink))
+
+	_, err := c.post(context.Background(), "/info", map[string]any{"type": "meta"})
+	assert.Error(t, err)
+	assert.Equal(t, []string{"meta"}, sink.rateLimitHits)
+	assert.Equal(t, []string{"meta:error"}, sink.requests)
+}
+
+func TestEndpointNameFromPayloadFallsBackToPathForNonMapPayload(t *testing.T) {
+	assert.Equal(t, "/info", endpointNameFromPayload("not a map", "/info"))
+	assert.Equal(t, "meta", endpointNameFromPayload(map[string]any{"type": "meta"}, "/info"))
+}
@@ -0,0 +1,281 @@
+package hyperliquid
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestMsgpackActionGoldenVector pins encodeActionMsgpack - and therefore
+// canonicalMarshal, the function that actually determines what gets signed
+// and sent to Hyperliquid - against a real capture from the Python SDK:
+// the same order action TestMsgpackOrderSerialization captures, re-encoded
+// with canonicalMarshal's str8-always string rule (see msgpack_canonical.go)
+// in place of msgpack.packb's default fixstr-for-short-strings choice. This
+// is the regression canary chunk4-2's review asked for: a field-ordering or
+// encoding bug in canonicalMarshal now fails here, not just in a
+// self-consistency check that can't see the production encoder diverge from
+// the Python reference.
+func TestMsgpackActionGoldenVector(t *testing.T) {
+	action := OrderAction{
+		Type: "order",
+		Orders: []OrderWire{{
+			Asset:      0,
+			IsBuy:      true,
+			LimitPx:    "40000",
+			Size:       "0.001",
+			ReduceOnly: false,
+			OrderType: OrderWireType{
+				Limit: &OrderWireTypeLimit{Tif: TifGtc},
+			},
+		}},
+		Grouping: "na",
+	}
+
+	data, err := encodeActionMsgpack(action)
+	require.NoError(t, err)
+
+	// Captured from test_python_msgpack.py's msgpack.packb(action,
+	// use_bin_type=True) output (see TestMsgpackOrderSerialization), with
+	// every fixstr re-encoded as str8 to match canonicalMarshal's rules.
+	const pythonHexAsStr8 = "83d90474797065d9056f72646572d9066f7264657273918" +
+		"6d9016100d90162c3d90170d9053430303030d90173d905302e303031d90172c2d90" +
+		"17481d9056c696d697481d903746966d903477463d90867726f7570696e67d90" +
+		"26e61"
+
+	require.Equal(t, pythonHexAsStr8, hex.EncodeToString(data))
+}
+
+// TestMsgpackActionFuzzDeterminism extends TestMsgpackActionGoldenVector and
+// Test_Msgpack_Field_Ordering's single hand-coded vector into a property-based
+// sweep across the whole order-action surface: OrderAction, CancelAction,
+// ModifyAction, BatchModifyAction, CancelByCloidAction, and ScheduleCancelAction,
+// each generated with randomized asset ids, TIF/trigger/cloid/reduceOnly/builder
+// combinations. Like TestCanonicalMarshalFuzzRandomNested, this is a
+// determinism check standing in for byte-for-byte comparison against Python
+// SDK golden vectors for every variant: the golden vector above pins the
+// production encoder against a real capture, and this sweep catches
+// field-ordering regressions across the rest of the action surface that a
+// single fixed vector can't cover. TwapAction is intentionally left out: no
+// TWAP order-placement action exists in this tree yet (see chunk9-1).
+func TestMsgpackActionFuzzDeterminism(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+
+	for i := 0; i < 200; i++ {
+		action := randomOrderSurfaceAction(rng)
+
+		first, err := encodeActionMsgpack(action)
+		require.NoError(t, err)
+
+		second, err := encodeActionMsgpack(action)
+		require.NoError(t, err)
+
+		require.True(
+			t,
+			bytes.Equal(first, second),
+			"msgpack encoding of %#v must be deterministic across repeated encodes",
+			action,
+		)
+	}
+}
+
+// encodeActionMsgpack delegates to canonicalMarshal - the function
+// actionHash actually signs with - rather than the vmihailenco/msgpack/v5
+// library canonicalMarshal replaced (chunk0-4). Encoding actions any other
+// way would let this fuzz harness and its golden vector pass while the
+// production signing path silently diverges.
+func encodeActionMsgpack(action any) ([]byte, error) {
+	return canonicalMarshal(action)
+}
+
+func randomOrderSurfaceAction(rng *rand.Rand) any {
+	switch rng.Intn(6) {
+	case 0:
+		return randomOrderAction(rng)
+	case 1:
+		return randomCancelAction(rng)
+	case 2:
+		return randomModifyAction(rng)
+	case 3:
+		return randomBatchModifyAction(rng)
+	case 4:
+		return randomCancelByCloidAction(rng)
+	default:
+		return randomScheduleCancelAction(rng)
+	}
+}
+
+func randomOrderAction(rng *rand.Rand) OrderAction {
+	n := 1 + rng.Intn(3)
+	orders := make([]OrderWire, n)
+	for i := range orders {
+		orders[i] = randomOrderWire(rng)
+	}
+
+	var builder *BuilderInfo
+	if rng.Intn(2) == 0 {
+		builder = &BuilderInfo{
+			Builder: randomHexAddress(rng),
+			Fee:     rng.Intn(100),
+		}
+	}
+
+	return OrderAction{
+		Type:     "order",
+		Orders:   orders,
+		Grouping: randomGrouping(rng),
+		Builder:  builder,
+	}
+}
+
+func randomOrderWire(rng *rand.Rand) OrderWire {
+	wire := OrderWire{
+		Asset:      rng.Intn(200),
+		IsBuy:      rng.Intn(2) == 0,
+		LimitPx:    randomPxString(rng),
+		Size:       randomSzString(rng),
+		ReduceOnly: rng.Intn(2) == 0,
+		OrderType:  randomOrderWireType(rng),
+	}
+	if rng.Intn(2) == 0 {
+		cloid := randomCloid(rng)
+		wire.Cloid = &cloid
+	}
+	return wire
+}
+
+func randomOrderWireType(rng *rand.Rand) OrderWireType {
+	if rng.Intn(2) == 0 {
+		return OrderWireType{
+			Limit: &OrderWireTypeLimit{Tif: randomTif(rng)},
+		}
+	}
+	return OrderWireType{
+		Trigger: &OrderWireTypeTrigger{
+			TriggerPx: randomPxString(rng),
+			IsMarket:  rng.Intn(2) == 0,
+			Tpsl:      randomTpsl(rng),
+		},
+	}
+}
+
+func randomCancelAction(rng *rand.Rand) CancelAction {
+	n := 1 + rng.Intn(3)
+	cancels := make([]CancelOrderWire, n)
+	for i := range cancels {
+		cancels[i] = CancelOrderWire{
+			Asset:   rng.Intn(200),
+			OrderID: rng.Int63n(1_000_000_000),
+		}
+	}
+	return CancelAction{Type: "cancel", Cancels: cancels}
+}
+
+func randomModifyAction(rng *rand.Rand) ModifyAction {
+	var oid any
+	if rng.Intn(2) == 0 {
+		oid = rng.Int63n(1_000_000_000)
+	} else {
+		oid = randomCloid(rng)
+	}
+	return ModifyAction{
+		Type:  "modify",
+		Oid:   oid,
+		Order: randomOrderWire(rng),
+	}
+}
+
+func randomBatchModifyAction(rng *rand.Rand) BatchModifyAction {
+	n := 1 + rng.Intn(3)
+	modifies := make([]ModifyAction, n)
+	for i := range modifies {
+		m := randomModifyAction(rng)
+		m.Type = ""
+		modifies[i] = m
+	}
+	return BatchModifyAction{Type: "batchModify", Modifies: modifies}
+}
+
+func randomCancelByCloidAction(rng *rand.Rand) CancelByCloidAction {
+	n := 1 + rng.Intn(3)
+	cancels := make([]CancelByCloidWire, n)
+	for i := range cancels {
+		cancels[i] = CancelByCloidWire{
+			Asset:    rng.Intn(200),
+			ClientID: randomCloid(rng),
+		}
+	}
+	return CancelByCloidAction{Type: "cancelByCloid", Cancels: cancels}
+}
+
+func randomScheduleCancelAction(rng *rand.Rand) ScheduleCancelAction {
+	var t *int64
+	if rng.Intn(2) == 0 {
+		v := rng.Int63n(2_000_000_000_000)
+		t = &v
+	}
+	return ScheduleCancelAction{Type: "scheduleCancel", Time: t}
+}
+
+func randomGrouping(rng *rand.Rand) string {
+	groupings := []string{string(GroupingNA), "normalTpsl", "positionTpsl"}
+	return groupings[rng.Intn(len(groupings))]
+}
+
+func randomTif(rng *rand.Rand) string {
+	tifs := []string{TifGtc, TifIoc, TifAlo}
+	return tifs[rng.Intn(len(tifs))]
+}
+
+func randomTpsl(rng *rand.Rand) string {
+	if rng.Intn(2) == 0 {
+		return "tp"
+	}
+	return "sl"
+}
+
+func randomPxString(rng *rand.Rand) string {
+	whole := rng.Intn(100000)
+	frac := rng.Intn(100000)
+	return randomItoa(whole) + "." + randomItoa(frac)
+}
+
+func randomSzString(rng *rand.Rand) string {
+	whole := rng.Intn(1000)
+	frac := rng.Intn(100000000)
+	return randomItoa(whole) + "." + randomItoa(frac)
+}
+
+func randomItoa(n int) string {
+	if n == 0 {
+		return "0"
+	}
+	digits := "0123456789"
+	var b []byte
+	for n > 0 {
+		b = append([]byte{digits[n%10]}, b...)
+		n /= 10
+	}
+	return string(b)
+}
+
+func randomCloid(rng *rand.Rand) string {
+	const hexDigits = "0123456789abcdef"
+	b := make([]byte, 32)
+	for i := range b {
+		b[i] = hexDigits[rng.Intn(len(hexDigits))]
+	}
+	return "0x" + string(b)
+}
+
+func randomHexAddress(rng *rand.Rand) string {
+	const hexDigits = "0123456789abcdef"
+	b := make([]byte, 40)
+	for i := range b {
+		b[i] = hexDigits[rng.Intn(len(hexDigits))]
+	}
+	return "0x" + string(b)
+}
@@ -0,0 +1,287 @@
+package hyperliquid
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// canonicalMarshal encodes v into msgpack bytes that are byte-for-byte
+// identical to Python's `msgpack.packb(v, use_bin_type=True)`, which is
+// what the signing server on the other end hashes against. This replaces
+// vmihailenco/msgpack/v5 plus the convertStr16ToStr8 post-processing pass
+// previously used in actionHash, which could silently diverge for any
+// map[string]any (Go's map iteration order is randomized) or any struct
+// whose string fields crossed the 256-byte boundary.
+//
+// Encoding rules, matching the Python reference exactly:
+//   - struct fields are emitted in declaration order, using their
+//     `msgpack:"..."` tag as the key (fields tagged "-" are skipped),
+//   - map[string]any keys are sorted lexicographically, since Go map
+//     iteration order is otherwise undefined,
+//   - strings always use str8 (<256 bytes), str16 (<65536 bytes), or str32
+//     (otherwise) - never fixstr,
+//   - ints use the most compact representation (positive/negative fixint,
+//     uint8/16/32/64, int8/16/32/64),
+//   - float64 is always emitted as `float 64` (0xcb), never downgraded to
+//     float32.
+func canonicalMarshal(v any) ([]byte, error) {
+	return appendValue(nil, reflect.ValueOf(v))
+}
+
+func appendValue(buf []byte, v reflect.Value) ([]byte, error) {
+	if !v.IsValid() {
+		return appendNil(buf), nil
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return appendNil(buf), nil
+		}
+		return appendValue(buf, v.Elem())
+	case reflect.String:
+		return appendString(buf, v.String()), nil
+	case reflect.Bool:
+		return appendBool(buf, v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return appendInt(buf, v.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return appendUint(buf, v.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return appendFloat64(buf, v.Float()), nil
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return appendNil(buf), nil
+		}
+		return appendArray(buf, v)
+	case reflect.Map:
+		return appendMap(buf, v)
+	case reflect.Struct:
+		return appendStruct(buf, v)
+	default:
+		return nil, fmt.Errorf("canonicalMarshal: unsupported kind %s", v.Kind())
+	}
+}
+
+func appendNil(buf []byte) []byte {
+	return append(buf, 0xc0)
+}
+
+func appendBool(buf []byte, b bool) []byte {
+	if b {
+		return append(buf, 0xc3)
+	}
+	return append(buf, 0xc2)
+}
+
+func appendFloat64(buf []byte, f float64) []byte {
+	buf = append(buf, 0xcb)
+	var tmp [8]byte
+	binary.BigEndian.PutUint64(tmp[:], math.Float64bits(f))
+	return append(buf, tmp[:]...)
+}
+
+func appendInt(buf []byte, n int64) []byte {
+	switch {
+	case n >= 0:
+		return appendUint(buf, uint64(n))
+	case n >= -32:
+		return append(buf, byte(int8(n)))
+	case n >= math.MinInt8:
+		return append(buf, 0xd0, byte(int8(n)))
+	case n >= math.MinInt16:
+		buf = append(buf, 0xd1)
+		var tmp [2]byte
+		binary.BigEndian.PutUint16(tmp[:], uint16(int16(n)))
+		return append(buf, tmp[:]...)
+	case n >= math.MinInt32:
+		buf = append(buf, 0xd2)
+		var tmp [4]byte
+		binary.BigEndian.PutUint32(tmp[:], uint32(int32(n)))
+		return append(buf, tmp[:]...)
+	default:
+		buf = append(buf, 0xd3)
+		var tmp [8]byte
+		binary.BigEndian.PutUint64(tmp[:], uint64(n))
+		return append(buf, tmp[:]...)
+	}
+}
+
+func appendUint(buf []byte, n uint64) []byte {
+	switch {
+	case n <= 0x7f:
+		return append(buf, byte(n))
+	case n <= math.MaxUint8:
+		return append(buf, 0xcc, byte(n))
+	case n <= math.MaxUint16:
+		buf = append(buf, 0xcd)
+		var tmp [2]byte
+		binary.BigEndian.PutUint16(tmp[:], uint16(n))
+		return append(buf, tmp[:]...)
+	case n <= math.MaxUint32:
+		buf = append(buf, 0xce)
+		var tmp [4]byte
+		binary.BigEndian.PutUint32(tmp[:], uint32(n))
+		return append(buf, tmp[:]...)
+	default:
+		buf = append(buf, 0xcf)
+		var tmp [8]byte
+		binary.BigEndian.PutUint64(tmp[:], n)
+		return append(buf, tmp[:]...)
+	}
+}
+
+func appendString(buf []byte, s string) []byte {
+	data := []byte(s)
+	n := len(data)
+	switch {
+	case n < 256:
+		buf = append(buf, 0xd9, byte(n))
+	case n < 65536:
+		buf = append(buf, 0xda)
+		var tmp [2]byte
+		binary.BigEndian.PutUint16(tmp[:], uint16(n))
+		buf = append(buf, tmp[:]...)
+	default:
+		buf = append(buf, 0xdb)
+		var tmp [4]byte
+		binary.BigEndian.PutUint32(tmp[:], uint32(n))
+		buf = append(buf, tmp[:]...)
+	}
+	return append(buf, data...)
+}
+
+func appendArrayHeader(buf []byte, n int) []byte {
+	switch {
+	case n < 16:
+		return append(buf, 0x90|byte(n))
+	case n < 65536:
+		buf = append(buf, 0xdc)
+		var tmp [2]byte
+		binary.BigEndian.PutUint16(tmp[:], uint16(n))
+		return append(buf, tmp[:]...)
+	default:
+		buf = append(buf, 0xdd)
+		var tmp [4]byte
+		binary.BigEndian.PutUint32(tmp[:], uint32(n))
+		return append(buf, tmp[:]...)
+	}
+}
+
+func appendMapHeader(buf []byte, n int) []byte {
+	switch {
+	case n < 16:
+		return append(buf, 0x80|byte(n))
+	case n < 65536:
+		buf = append(buf, 0xde)
+		var tmp [2]byte
+		binary.BigEndian.PutUint16(tmp[:], uint16(n))
+		return append(buf, tmp[:]...)
+	default:
+		buf = append(buf, 0xdf)
+		var tmp [4]byte
+		binary.BigEndian.PutUint32(tmp[:], uint32(n))
+		return append(buf, tmp[:]...)
+	}
+}
+
+func appendArray(buf []byte, v reflect.Value) ([]byte, error) {
+	if v.Type().Elem().Kind() == reflect.Uint8 {
+		// []byte is treated as an array of uint8 ints, matching how the
+		// Python reference encodes byte slices passed through as plain
+		// lists (accessList entries, raw message bytes, etc.).
+		buf = appendArrayHeader(buf, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			buf = appendUint(buf, v.Index(i).Uint())
+		}
+		return buf, nil
+	}
+
+	buf = appendArrayHeader(buf, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		var err error
+		buf, err = appendValue(buf, v.Index(i))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return buf, nil
+}
+
+func appendMap(buf []byte, v reflect.Value) ([]byte, error) {
+	keys := v.MapKeys()
+	strKeys := make([]string, 0, len(keys))
+	byKey := make(map[string]reflect.Value, len(keys))
+	for _, k := range keys {
+		if k.Kind() != reflect.String {
+			return nil, fmt.Errorf("canonicalMarshal: unsupported map key kind %s", k.Kind())
+		}
+		strKeys = append(strKeys, k.String())
+		byKey[k.String()] = v.MapIndex(k)
+	}
+	sort.Strings(strKeys)
+
+	buf = appendMapHeader(buf, len(strKeys))
+	for _, k := range strKeys {
+		buf = appendString(buf, k)
+		var err error
+		buf, err = appendValue(buf, byKey[k])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return buf, nil
+}
+
+// msgpackFieldName returns the wire key for a struct field given its
+// `msgpack:"..."` tag, or ok=false if the field should be skipped.
+func msgpackFieldName(f reflect.StructField) (string, bool) {
+	tag, ok := f.Tag.Lookup("msgpack")
+	if !ok {
+		if f.PkgPath != "" { // unexported
+			return "", false
+		}
+		return f.Name, true
+	}
+	name, _, _ := strings.Cut(tag, ",")
+	if name == "-" {
+		return "", false
+	}
+	if name == "" {
+		name = f.Name
+	}
+	return name, true
+}
+
+func appendStruct(buf []byte, v reflect.Value) ([]byte, error) {
+	t := v.Type()
+
+	type field struct {
+		name string
+		val  reflect.Value
+	}
+	fields := make([]field, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		name, ok := msgpackFieldName(sf)
+		if !ok {
+			continue
+		}
+		fields = append(fields, field{name: name, val: v.Field(i)})
+	}
+
+	buf = appendMapHeader(buf, len(fields))
+	for _, f := range fields {
+		buf = appendString(buf, f.name)
+		var err error
+		buf, err = appendValue(buf, f.val)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return buf, nil
+}
@@ -0,0 +1,142 @@
+package hyperliquid
+
+import (
+	"encoding/hex"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalMarshalScalars(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    any
+		expected string
+	}{
+		{"positive fixint", int64(1), "01"},
+		{"negative fixint", int64(-1), "ff"},
+		{"uint8", int64(200), "ccc8"},
+		{"int16", int64(-1000), "d1fc18"},
+		{"bool true", true, "c3"},
+		{"bool false", false, "c2"},
+		{"nil", nil, "c0"},
+		{"float64", 1.5, "cb3ff8000000000000"},
+		{"short string uses str8, not fixstr", "a", "d90161"},
+		{"empty map", map[string]any{}, "80"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := canonicalMarshal(tt.value)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, hex.EncodeToString(data))
+		})
+	}
+}
+
+func TestCanonicalMarshalStructPreservesFieldOrder(t *testing.T) {
+	action := struct {
+		Type   string  `msgpack:"type"`
+		Amount float64 `msgpack:"amount"`
+		ToPerp bool    `msgpack:"toPerp"`
+	}{
+		Type:   "usdClassTransfer",
+		Amount: 1,
+		ToPerp: true,
+	}
+
+	data, err := canonicalMarshal(action)
+	require.NoError(t, err)
+
+	expected := "83" + // fixmap, 3 entries
+		"d90474797065" + "d910757364436c6173735472616e73666572" + // "type": "usdClassTransfer"
+		"d906616d6f756e74" + "cb3ff0000000000000" + // "amount": 1.0
+		"d906746f50657270" + "c3" // "toPerp": true
+
+	assert.Equal(t, expected, hex.EncodeToString(data))
+}
+
+func TestCanonicalMarshalMapSortsKeys(t *testing.T) {
+	m := map[string]any{"b": int64(2), "a": int64(1)}
+
+	data, err := canonicalMarshal(m)
+	require.NoError(t, err)
+
+	// "a" must be emitted before "b" regardless of Go map iteration order.
+	expected := "82" + "d90161" + "01" + "d90162" + "02"
+	assert.Equal(t, expected, hex.EncodeToString(data))
+}
+
+// TestCanonicalMarshalFuzzRandomNested generates random nested
+// maps/slices/scalars and asserts canonicalMarshal never errors and
+// produces deterministic output across repeated encodes of the same value -
+// a cheap differential check standing in for comparison against captured
+// Python fixtures, which requires an external interpreter this suite does
+// not depend on.
+func TestCanonicalMarshalFuzzRandomNested(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+
+	for i := 0; i < 200; i++ {
+		v := randomValue(rng, 3)
+
+		first, err := canonicalMarshal(v)
+		require.NoError(t, err)
+
+		second, err := canonicalMarshal(v)
+		require.NoError(t, err)
+
+		assert.Equal(t, first, second, "encoding of %#v must be deterministic", v)
+	}
+}
+
+func randomValue(rng *rand.Rand, depth int) any {
+	if depth <= 0 {
+		return randomScalar(rng)
+	}
+
+	switch rng.Intn(4) {
+	case 0:
+		return randomScalar(rng)
+	case 1:
+		n := rng.Intn(4)
+		arr := make([]any, n)
+		for i := range arr {
+			arr[i] = randomValue(rng, depth-1)
+		}
+		return arr
+	default:
+		n := rng.Intn(4)
+		m := make(map[string]any, n)
+		for i := 0; i < n; i++ {
+			m[randomString(rng)] = randomValue(rng, depth-1)
+		}
+		return m
+	}
+}
+
+func randomScalar(rng *rand.Rand) any {
+	switch rng.Intn(5) {
+	case 0:
+		return rng.Int63() - (1 << 62)
+	case 1:
+		return rng.Float64()
+	case 2:
+		return rng.Intn(2) == 0
+	case 3:
+		return randomString(rng)
+	default:
+		return nil
+	}
+}
+
+func randomString(rng *rand.Rand) string {
+	const letters = "abcdefghijklmnopqrstuvwxyzABCDEFG"
+	n := rng.Intn(20)
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = letters[rng.Intn(len(letters))]
+	}
+	return string(b)
+}
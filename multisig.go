@@ -0,0 +1,402 @@
+package hyperliquid
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// UserMultiSigSigners describes the authorized co-signers and threshold
+// recorded on-chain for a multi-sig user via convertToMultiSigUser.
+type UserMultiSigSigners struct {
+	AuthorizedUsers []string `json:"authorizedUsers"`
+	Threshold       int      `json:"threshold"`
+}
+
+// MultiSigSigners fetches the authorized signers and threshold for a
+// multi-sig user.
+func (i *Info) MultiSigSigners(user string) (*UserMultiSigSigners, error) {
+	return i.MultiSigSignersWithContext(context.Background(), user)
+}
+
+// MultiSigSignersWithContext is the context-aware variant of MultiSigSigners.
+func (i *Info) MultiSigSignersWithContext(
+	ctx context.Context,
+	user string,
+) (*UserMultiSigSigners, error) {
+	resp, err := i.client.post(ctx, "/info", map[string]any{
+		"type": "userToMultiSigSigners",
+		"user": user,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch multi-sig signers: %w", err)
+	}
+
+	var result UserMultiSigSigners
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal multi-sig signers: %w", err)
+	}
+	return &result, nil
+}
+
+// multiSigPartialSignature is a signature collected from one co-signer,
+// already verified to belong to the claimed address.
+type multiSigPartialSignature struct {
+	signer    string
+	signature SignatureResult
+}
+
+// MultiSigCoordinator collects and verifies the partial signatures needed to
+// assemble a signMultiSigAction payload. The flow is:
+//
+//  1. NewMultiSigCoordinator wraps the inner action for a given multi-sig
+//     user and outer nonce.
+//  2. Each co-signer signs the bytes returned by Digest (offline mode:
+//     hardware wallets / remote signers only ever need this).
+//  3. AddSignature verifies and records each partial signature by ecrecover
+//     against the expected signer address.
+//  4. Once Threshold signatures have been collected, Finalize signs the
+//     outer signMultiSigAction envelope with the leader's key and returns
+//     the assembled action ready to POST.
+type MultiSigCoordinator struct {
+	innerAction  map[string]any
+	multiSigUser string
+	nonce        int64
+	isMainnet    bool
+	threshold    int
+
+	signatures        []multiSigPartialSignature
+	authorizedSigners map[string]bool
+}
+
+// NewMultiSigCoordinator creates a coordinator for innerAction, to be
+// executed on behalf of multiSigUser at the given outer nonce.
+func NewMultiSigCoordinator(
+	innerAction map[string]any,
+	multiSigUser string,
+	nonce int64,
+	threshold int,
+	isMainnet bool,
+) *MultiSigCoordinator {
+	return &MultiSigCoordinator{
+		innerAction:  innerAction,
+		multiSigUser: multiSigUser,
+		nonce:        nonce,
+		threshold:    threshold,
+		isMainnet:    isMainnet,
+	}
+}
+
+// WithAuthorizedSigners restricts AddSignature and PayloadHashForSigner to
+// addresses in signers (typically UserMultiSigSigners.AuthorizedUsers, as
+// fetched via Info.MultiSigSignersWithContext). Without this, a valid
+// signature from an address that meets the threshold count but was never
+// actually authorized on-chain would otherwise be accepted.
+func (c *MultiSigCoordinator) WithAuthorizedSigners(signers []string) *MultiSigCoordinator {
+	c.authorizedSigners = make(map[string]bool, len(signers))
+	for _, s := range signers {
+		c.authorizedSigners[strings.ToLower(s)] = true
+	}
+	return c
+}
+
+// isAuthorized reports whether signer is allowed to contribute a partial
+// signature. When WithAuthorizedSigners has not been called, every address
+// is allowed, preserving the coordinator's prior behavior.
+func (c *MultiSigCoordinator) isAuthorized(signer string) bool {
+	if c.authorizedSigners == nil {
+		return true
+	}
+	return c.authorizedSigners[strings.ToLower(signer)]
+}
+
+// PayloadHashForSigner returns the exact bytes signer must sign over, i.e.
+// Digest, after verifying signer is in the authorized set configured via
+// WithAuthorizedSigners. Hardware wallets and remote/offline signers should
+// call this (or receive its output via MarshalRequest) rather than Digest
+// directly, so an accidental request to an unauthorized cosigner fails
+// before anything is signed.
+func (c *MultiSigCoordinator) PayloadHashForSigner(signerAddr string) ([]byte, error) {
+	if !c.isAuthorized(signerAddr) {
+		return nil, fmt.Errorf("signer %s is not an authorized multi-sig co-signer", signerAddr)
+	}
+	return c.Digest(), nil
+}
+
+// Sign signs Digest with privateKey directly and records the result via
+// AddSignature, for co-signers running in the same process as the
+// coordinator. Remote cosigners should use MarshalRequest/UnmarshalResponse
+// instead, since they never have access to the coordinator's innerAction.
+func (c *MultiSigCoordinator) Sign(privateKey *ecdsa.PrivateKey) (SignatureResult, error) {
+	signer := NewLocalSigner(privateKey)
+	if !c.isAuthorized(signer.Address().Hex()) {
+		return SignatureResult{}, fmt.Errorf(
+			"signer %s is not an authorized multi-sig co-signer", signer.Address().Hex(),
+		)
+	}
+
+	sig, err := signer.SignHash(context.Background(), c.Digest())
+	if err != nil {
+		return SignatureResult{}, fmt.Errorf("sign multi-sig digest: %w", err)
+	}
+	if err := c.AddSignature(signer.Address().Hex(), sig); err != nil {
+		return SignatureResult{}, err
+	}
+	return sig, nil
+}
+
+// MultiSigSignRequest is the JSON envelope sent to an out-of-process
+// co-signer that has no access to the coordinator's in-memory state. It
+// carries everything needed to reconstruct PayloadHashForSigner's digest
+// without exchanging private keys.
+type MultiSigSignRequest struct {
+	InnerAction  map[string]any `json:"innerAction"`
+	MultiSigUser string         `json:"multiSigUser"`
+	Nonce        int64          `json:"nonce"`
+	IsMainnet    bool           `json:"isMainnet"`
+	SignerAddr   string         `json:"signerAddr"`
+	Digest       hexutil.Bytes  `json:"digest"`
+}
+
+// MultiSigSignResponse is what a remote co-signer sends back after signing
+// a MultiSigSignRequest's Digest.
+type MultiSigSignResponse struct {
+	Signer    string          `json:"signer"`
+	Signature SignatureResult `json:"signature"`
+}
+
+// MarshalRequest builds the MultiSigSignRequest for signerAddr and encodes
+// it as JSON, for dispatch to a co-signer running on another machine (e.g.
+// over a queue or an internal RPC) that will call
+// UnmarshalMultiSigSignRequest, sign the digest itself, and send back a
+// MultiSigSignResponse for UnmarshalResponse.
+func (c *MultiSigCoordinator) MarshalRequest(signerAddr string) ([]byte, error) {
+	digest, err := c.PayloadHashForSigner(signerAddr)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(MultiSigSignRequest{
+		InnerAction:  c.innerAction,
+		MultiSigUser: c.multiSigUser,
+		Nonce:        c.nonce,
+		IsMainnet:    c.isMainnet,
+		SignerAddr:   signerAddr,
+		Digest:       digest,
+	})
+}
+
+// UnmarshalMultiSigSignRequest parses a MultiSigSignRequest produced by
+// MarshalRequest. A remote co-signer process uses this on its side of the
+// channel: it never reconstructs a MultiSigCoordinator, it just signs
+// req.Digest with its own key and returns a MultiSigSignResponse.
+func UnmarshalMultiSigSignRequest(data []byte) (*MultiSigSignRequest, error) {
+	var req MultiSigSignRequest
+	if err := json.Unmarshal(data, &req); err != nil {
+		return nil, fmt.Errorf("unmarshal multi-sig sign request: %w", err)
+	}
+	return &req, nil
+}
+
+// UnmarshalResponse parses a MultiSigSignResponse from a remote co-signer
+// and records it via AddSignature.
+func (c *MultiSigCoordinator) UnmarshalResponse(data []byte) error {
+	var resp MultiSigSignResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return fmt.Errorf("unmarshal multi-sig sign response: %w", err)
+	}
+	return c.AddSignature(resp.Signer, resp.Signature)
+}
+
+// Digest returns the phantom-agent hash each co-signer must sign: the
+// action hash of the wrapped inner action, using the multi-sig account as
+// vaultAddress. This is the same digest used in offline mode, so hardware
+// wallets and remote signers only ever need this method.
+func (c *MultiSigCoordinator) Digest() []byte {
+	return actionHash(c.innerAction, c.multiSigUser, c.nonce, nil)
+}
+
+// AddSignature verifies that signature was produced by signer over Digest
+// and, if so, records it. Duplicate signers overwrite their previous
+// signature rather than being recorded twice.
+func (c *MultiSigCoordinator) AddSignature(signer string, signature SignatureResult) error {
+	if !c.isAuthorized(signer) {
+		return fmt.Errorf("signer %s is not an authorized multi-sig co-signer", signer)
+	}
+
+	recovered, err := recoverL1Signer(c.Digest(), c.isMainnet, signature)
+	if err != nil {
+		return fmt.Errorf("failed to recover signer: %w", err)
+	}
+
+	if !strings.EqualFold(recovered.Hex(), common.HexToAddress(signer).Hex()) {
+		return fmt.Errorf(
+			"signature does not match claimed signer: expected %s, recovered %s",
+			signer,
+			recovered.Hex(),
+		)
+	}
+
+	for idx, existing := range c.signatures {
+		if strings.EqualFold(existing.signer, signer) {
+			c.signatures[idx].signature = signature
+			return nil
+		}
+	}
+
+	c.signatures = append(c.signatures, multiSigPartialSignature{
+		signer:    signer,
+		signature: signature,
+	})
+	return nil
+}
+
+// Ready reports whether enough verified partial signatures have been
+// collected to satisfy the multi-sig threshold.
+func (c *MultiSigCoordinator) Ready() bool {
+	return len(c.signatures) >= c.threshold
+}
+
+// orderedSignersAndSignatures returns the collected signers/signatures
+// sorted by lower-cased address, the canonical order expected by the L1.
+func (c *MultiSigCoordinator) orderedSignersAndSignatures() ([]string, []string, error) {
+	sorted := make([]multiSigPartialSignature, len(c.signatures))
+	copy(sorted, c.signatures)
+	sort.Slice(sorted, func(a, b int) bool {
+		return strings.ToLower(sorted[a].signer) < strings.ToLower(sorted[b].signer)
+	})
+
+	signers := make([]string, len(sorted))
+	signatures := make([]string, len(sorted))
+	for idx, s := range sorted {
+		signers[idx] = s.signer
+		sig, err := formatMultiSigSignature(s.signature)
+		if err != nil {
+			return nil, nil, fmt.Errorf("signer %s: %w", s.signer, err)
+		}
+		signatures[idx] = sig
+	}
+	return signers, signatures, nil
+}
+
+// formatMultiSigSignature renders sig as the fixed-width "0x" + 64 hex
+// chars of r + 64 hex chars of s + 2 hex chars of v string the L1 expects.
+// r and s are zero-padded to 32 bytes each rather than concatenated as-is,
+// since hexutil.EncodeBig (what every Signer.SignHash implementation uses
+// to build SignatureResult) strips leading zero bytes, which would
+// otherwise shift the r/s/v boundaries whenever a recovered r or s has
+// one.
+func formatMultiSigSignature(sig SignatureResult) (string, error) {
+	r, err := hexutil.DecodeBig(sig.R)
+	if err != nil {
+		return "", fmt.Errorf("invalid signature r: %w", err)
+	}
+	s, err := hexutil.DecodeBig(sig.S)
+	if err != nil {
+		return "", fmt.Errorf("invalid signature s: %w", err)
+	}
+
+	var rb, sb [32]byte
+	r.FillBytes(rb[:])
+	s.FillBytes(sb[:])
+
+	return fmt.Sprintf("0x%x%x%02x", rb, sb, sig.V), nil
+}
+
+// Finalize signs the outer signMultiSigAction envelope with the leader's
+// key and returns the assembled action plus its signature, ready to POST
+// via Exchange.postAction. It returns an error if fewer than Threshold
+// partial signatures have been collected.
+func (c *MultiSigCoordinator) Finalize(
+	leaderSign func(action any) (SignatureResult, error),
+) (signMultiSigAction, SignatureResult, error) {
+	if !c.Ready() {
+		return signMultiSigAction{}, SignatureResult{}, fmt.Errorf(
+			"multi-sig: have %d of %d required signatures",
+			len(c.signatures),
+			c.threshold,
+		)
+	}
+
+	signers, signatures, err := c.orderedSignersAndSignatures()
+	if err != nil {
+		return signMultiSigAction{}, SignatureResult{}, fmt.Errorf("multi-sig: format signatures: %w", err)
+	}
+	action := signMultiSigAction{
+		Type:       "multiSig",
+		Action:     c.innerAction,
+		Signers:    signers,
+		Signatures: signatures,
+	}
+
+	sig, err := leaderSign(action)
+	if err != nil {
+		return signMultiSigAction{}, SignatureResult{}, fmt.Errorf("failed to sign outer action: %w", err)
+	}
+	return action, sig, nil
+}
+
+// recoverL1Signer recovers the address that produced signature over an L1
+// action hash, following the same phantom-agent/EIP-712 path as
+// SignL1Action.
+func recoverL1Signer(hash []byte, isMainnet bool, signature SignatureResult) (common.Address, error) {
+	phantomAgent := constructPhantomAgent(hash, isMainnet)
+	typedData := l1Payload(phantomAgent, isMainnet)
+
+	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to hash domain: %w", err)
+	}
+	typedDataHash, err := hashStructLenient(typedData, typedData.PrimaryType, typedData.Message)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to hash typed data: %w", err)
+	}
+
+	rawData := []byte{0x19, 0x01}
+	rawData = append(rawData, domainSeparator...)
+	rawData = append(rawData, typedDataHash...)
+	msgHash := crypto.Keccak256(rawData)
+
+	sig, err := signatureResultToBytes(signature)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	pubKey, err := crypto.SigToPub(msgHash, sig)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to recover public key: %w", err)
+	}
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
+
+// signatureResultToBytes converts a SignatureResult back into the 65-byte
+// [R || S || V] form expected by crypto.SigToPub, where V is 0/1.
+func signatureResultToBytes(sig SignatureResult) ([]byte, error) {
+	r, err := hexutil.DecodeBig(sig.R)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature r: %w", err)
+	}
+	s, err := hexutil.DecodeBig(sig.S)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature s: %w", err)
+	}
+
+	out := make([]byte, 65)
+	rb := r.Bytes()
+	sb := s.Bytes()
+	copy(out[32-len(rb):32], rb)
+	copy(out[64-len(sb):64], sb)
+
+	v := sig.V
+	if v >= 27 {
+		v -= 27
+	}
+	out[64] = byte(v)
+	return out, nil
+}
@@ -0,0 +1,84 @@
+package hyperliquid
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiSigCoordinatorAddSignature(t *testing.T) {
+	signerKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signerAddr := crypto.PubkeyToAddress(signerKey.PublicKey).Hex()
+
+	innerAction := map[string]any{
+		"type":   "scheduleCancel",
+		"coin":   "ETH",
+		"amount": 1,
+	}
+
+	coord := NewMultiSigCoordinator(innerAction, "0x000000000000000000000000000000000000aa", 1, 1, false)
+	digest := coord.Digest()
+
+	phantomAgent := constructPhantomAgent(digest, false)
+	typedData := l1Payload(phantomAgent, false)
+	sig, err := signInner(signerKey, typedData)
+	require.NoError(t, err)
+
+	require.NoError(t, coord.AddSignature(signerAddr, sig))
+	assert.True(t, coord.Ready())
+
+	action, _, err := coord.Finalize(func(action any) (SignatureResult, error) {
+		return SignatureResult{R: "0x1", S: "0x1", V: 27}, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "multiSig", action.Type)
+	assert.Equal(t, []string{signerAddr}, action.Signers)
+	assert.Len(t, action.Signatures, 1)
+	assert.Len(t, action.Signatures[0], 132, "expected 0x + 64 hex r + 64 hex s + 2 hex v")
+}
+
+// TestFormatMultiSigSignatureZeroPadsLeadingZeroBytes guards against the
+// r/s/v boundaries shifting when a recovered r or s has a leading zero
+// byte: hexutil.EncodeBig (what every Signer.SignHash uses to build
+// SignatureResult) strips it, so naively concatenating R/S as-is would
+// produce a signature shorter than the L1 expects.
+func TestFormatMultiSigSignatureZeroPadsLeadingZeroBytes(t *testing.T) {
+	sig, err := formatMultiSigSignature(SignatureResult{
+		R: "0x1",   // a single hex digit: the extreme case of a short r
+		S: "0xabc", // likewise a short s
+		V: 27,
+	})
+	require.NoError(t, err)
+	wantR := strings.Repeat("0", 63) + "1"
+	wantS := strings.Repeat("0", 61) + "abc"
+	assert.Equal(t, "0x"+wantR+wantS+"1b", sig)
+}
+
+func TestMultiSigCoordinatorRejectsWrongSigner(t *testing.T) {
+	signerKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	coord := NewMultiSigCoordinator(map[string]any{"type": "noop"}, "0xaa", 1, 1, false)
+	digest := coord.Digest()
+
+	phantomAgent := constructPhantomAgent(digest, false)
+	typedData := l1Payload(phantomAgent, false)
+	sig, err := signInner(signerKey, typedData)
+	require.NoError(t, err)
+
+	err = coord.AddSignature("0x000000000000000000000000000000000000bb", sig)
+	assert.Error(t, err)
+	assert.False(t, coord.Ready())
+}
+
+func TestMultiSigCoordinatorFinalizeBeforeThreshold(t *testing.T) {
+	coord := NewMultiSigCoordinator(map[string]any{"type": "noop"}, "0xaa", 1, 2, false)
+	_, _, err := coord.Finalize(func(action any) (SignatureResult, error) {
+		return SignatureResult{}, nil
+	})
+	assert.Error(t, err)
+}
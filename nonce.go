@@ -0,0 +1,396 @@
+package hyperliquid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// NonceStore persists the high-water mark nonce per API-wallet address so a
+// restarted process does not reuse nonces already seen by the L1.
+type NonceStore interface {
+	// Load returns the last known nonce for address, or 0 if none is known.
+	Load(address string) (int64, error)
+	// Save persists nonce as the new high-water mark for address.
+	Save(address string, nonce int64) error
+}
+
+// MemoryNonceStore is the default in-memory NonceStore. High-water marks do
+// not survive process restarts.
+type MemoryNonceStore struct {
+	mu    sync.Mutex
+	marks map[string]int64
+}
+
+// NewMemoryNonceStore creates an empty in-memory NonceStore.
+func NewMemoryNonceStore() *MemoryNonceStore {
+	return &MemoryNonceStore{marks: make(map[string]int64)}
+}
+
+func (s *MemoryNonceStore) Load(address string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.marks[address], nil
+}
+
+func (s *MemoryNonceStore) Save(address string, nonce int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.marks[address] = nonce
+	return nil
+}
+
+// FileNonceStore persists high-water marks to a JSON file on disk, one
+// entry per address, so nonces survive process restarts.
+type FileNonceStore struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewFileNonceStore creates a FileNonceStore backed by path. The file is
+// created lazily on the first Save.
+func NewFileNonceStore(path string) *FileNonceStore {
+	return &FileNonceStore{path: path}
+}
+
+func (s *FileNonceStore) Load(address string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	marks, err := s.read()
+	if err != nil {
+		return 0, err
+	}
+	return marks[address], nil
+}
+
+func (s *FileNonceStore) Save(address string, nonce int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	marks, err := s.read()
+	if err != nil {
+		return err
+	}
+	marks[address] = nonce
+
+	data, err := json.Marshal(marks)
+	if err != nil {
+		return fmt.Errorf("failed to marshal nonce store: %w", err)
+	}
+	return writeFileAtomic(s.path, data)
+}
+
+// writeFileAtomic writes data to path via a temp file in the same
+// directory, fsyncs it, then renames it over path, so a crash mid-write
+// can never leave a torn or empty nonce store for the next process to load
+// a wrong (too-low) high-water mark from.
+func writeFileAtomic(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temp nonce store file: %w", err)
+	}
+	tmpPath := tmp.Name()
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmpPath)
+		return fmt.Errorf("failed to write temp nonce store file: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		os.Remove(tmpPath)
+		return fmt.Errorf("failed to fsync temp nonce store file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmpPath)
+		return fmt.Errorf("failed to close temp nonce store file: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		os.Remove(tmpPath)
+		return fmt.Errorf("failed to rename nonce store into place: %w", err)
+	}
+	return nil
+}
+
+func (s *FileNonceStore) read() (map[string]int64, error) {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return make(map[string]int64), nil
+		}
+		return nil, fmt.Errorf("failed to read nonce store: %w", err)
+	}
+
+	marks := make(map[string]int64)
+	if len(data) == 0 {
+		return marks, nil
+	}
+	if err := json.Unmarshal(data, &marks); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal nonce store: %w", err)
+	}
+	return marks, nil
+}
+
+// RedisNonceStoreClient is the minimal Redis surface RedisNonceStore needs.
+// It is satisfied by e.g. github.com/redis/go-redis/v9's *redis.Client,
+// without this package taking a direct dependency on any Redis driver.
+type RedisNonceStoreClient interface {
+	Get(key string) (string, error)
+	Set(key, value string) error
+}
+
+// RedisNonceStore is a stub NonceStore for deployments that share nonce
+// state across multiple processes. Wire in a RedisNonceStoreClient backed
+// by a real Redis driver to use it in production.
+type RedisNonceStore struct {
+	client    RedisNonceStoreClient
+	keyPrefix string
+}
+
+// NewRedisNonceStore creates a RedisNonceStore that namespaces keys under
+// keyPrefix (e.g. "hyperliquid:nonce:").
+func NewRedisNonceStore(client RedisNonceStoreClient, keyPrefix string) *RedisNonceStore {
+	return &RedisNonceStore{client: client, keyPrefix: keyPrefix}
+}
+
+func (s *RedisNonceStore) key(address string) string {
+	return s.keyPrefix + address
+}
+
+func (s *RedisNonceStore) Load(address string) (int64, error) {
+	val, err := s.client.Get(s.key(address))
+	if err != nil {
+		return 0, fmt.Errorf("failed to load nonce from redis: %w", err)
+	}
+	if val == "" {
+		return 0, nil
+	}
+	var nonce int64
+	if _, err := fmt.Sscanf(val, "%d", &nonce); err != nil {
+		return 0, fmt.Errorf("failed to parse nonce from redis: %w", err)
+	}
+	return nonce, nil
+}
+
+func (s *RedisNonceStore) Save(address string, nonce int64) error {
+	if err := s.client.Set(s.key(address), fmt.Sprintf("%d", nonce)); err != nil {
+		return fmt.Errorf("failed to save nonce to redis: %w", err)
+	}
+	return nil
+}
+
+// NonceManagerMetrics holds counters tracking NonceManager activity.
+// All fields are safe for concurrent use.
+type NonceManagerMetrics struct {
+	// Reservations counts successful calls to Reserve.
+	Reservations atomic.Int64
+	// Rewinds counts reservations successfully rolled back via Release.
+	Rewinds atomic.Int64
+	// Collisions counts Release calls that could not rewind because a
+	// later nonce had already been issued for the same address.
+	Collisions atomic.Int64
+}
+
+// NonceReservation represents a contiguous window of nonces reserved for a
+// batch of actions. Call Commit once the HTTP response confirms the
+// actions were accepted, or Release to roll the counter back when safe
+// (i.e. no nonce beyond this reservation has been issued for the address).
+type NonceReservation struct {
+	manager  *NonceManager
+	address  string
+	start    int64
+	count    int
+	resolved bool
+}
+
+// Nonces returns the reserved nonces in ascending order.
+func (r *NonceReservation) Nonces() []int64 {
+	nonces := make([]int64, r.count)
+	for i := range nonces {
+		nonces[i] = r.start + int64(i)
+	}
+	return nonces
+}
+
+// Commit marks the reservation as used. The high-water mark already
+// reflects the reservation, so this is purely bookkeeping.
+func (r *NonceReservation) Commit() {
+	r.resolved = true
+}
+
+// Release rewinds the nonce counter for this reservation's address back to
+// immediately before it, so the reserved nonces can be handed out again.
+// It only succeeds if no nonce past this reservation has been issued since
+// (e.g. by a concurrent goroutine); otherwise it returns an error and bumps
+// NonceManagerMetrics.Collisions.
+func (r *NonceReservation) Release() error {
+	if r.resolved {
+		return fmt.Errorf("nonce reservation already resolved")
+	}
+	err := r.manager.release(r)
+	r.resolved = true
+	return err
+}
+
+// NonceManager hands out strictly monotonic nonces per API-wallet address,
+// tracking max(now_ms, last+1) the way Ethereum tx-pool implementations
+// track account nonces. It supports reserving a window of nonces up-front
+// (for batched actions) and releasing that window if the batch is aborted
+// before any HTTP call was made.
+type NonceManager struct {
+	mu      sync.Mutex
+	store   NonceStore
+	last    map[string]int64
+	Metrics NonceManagerMetrics
+}
+
+// NewNonceManager creates a NonceManager backed by store. A nil store
+// defaults to an in-memory MemoryNonceStore.
+func NewNonceManager(store NonceStore) *NonceManager {
+	if store == nil {
+		store = NewMemoryNonceStore()
+	}
+	return &NonceManager{
+		store: store,
+		last:  make(map[string]int64),
+	}
+}
+
+// Next returns a single strictly monotonic nonce for address.
+func (m *NonceManager) Next(address string) (int64, error) {
+	reservation, err := m.Reserve(address, 1)
+	if err != nil {
+		return 0, err
+	}
+	reservation.Commit()
+	return reservation.start, nil
+}
+
+// Reserve allocates a contiguous window of n nonces for address. The
+// caller must Commit or Release the returned reservation once the outcome
+// of the batch is known.
+func (m *NonceManager) Reserve(address string, n int) (*NonceReservation, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("nonce reservation size must be positive, got %d", n)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	last, ok := m.last[address]
+	if !ok {
+		stored, err := m.store.Load(address)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load nonce high-water mark: %w", err)
+		}
+		last = clampNonceToWindow(stored, time.Now().UnixMilli())
+	}
+
+	now := time.Now().UnixMilli()
+	start := last + 1
+	if now > start {
+		start = now
+	}
+	end := start + int64(n) - 1
+
+	if err := m.store.Save(address, end); err != nil {
+		return nil, fmt.Errorf("failed to persist nonce high-water mark: %w", err)
+	}
+	m.last[address] = end
+	m.Metrics.Reservations.Add(1)
+
+	return &NonceReservation{
+		manager: m,
+		address: address,
+		start:   start,
+		count:   n,
+	}, nil
+}
+
+// release rewinds the high-water mark for r.address back to r.start-1, but
+// only if nothing has been issued past r since it was reserved.
+func (m *NonceManager) release(r *NonceReservation) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	end := r.start + int64(r.count) - 1
+	if m.last[r.address] != end {
+		m.Metrics.Collisions.Add(1)
+		return fmt.Errorf(
+			"cannot release reservation for %s: a later nonce was already issued",
+			r.address,
+		)
+	}
+
+	rewound := r.start - 1
+	if err := m.store.Save(r.address, rewound); err != nil {
+		return fmt.Errorf("failed to persist rewound nonce: %w", err)
+	}
+	m.last[r.address] = rewound
+	m.Metrics.Rewinds.Add(1)
+	return nil
+}
+
+const (
+	// nonceWindowPast and nonceWindowFuture mirror the (T-2d, T+1d)
+	// window documented on Exchange.nextNonce: Hyperliquid rejects any
+	// nonce outside it, so a persisted high-water mark from outside the
+	// window (stale store, clock skew, corruption) must be clamped back
+	// into range rather than trusted as-is.
+	nonceWindowPast   = 2 * 24 * time.Hour
+	nonceWindowFuture = 1 * 24 * time.Hour
+)
+
+// clampNonceToWindow clamps a persisted nonce into (now-nonceWindowPast,
+// now+nonceWindowFuture) relative to nowMillis, so a stale or corrupted
+// NonceStore entry can't push every subsequent reservation outside the
+// window the L1 will accept.
+func clampNonceToWindow(nonce, nowMillis int64) int64 {
+	lower := nowMillis - nonceWindowPast.Milliseconds()
+	upper := nowMillis + nonceWindowFuture.Milliseconds()
+	if nonce < lower {
+		return lower
+	}
+	if nonce > upper {
+		return upper
+	}
+	return nonce
+}
+
+// RecoverFromCrash checks whether actions landed around address's
+// persisted high-water mark that the process might not know about after
+// an unclean shutdown (e.g. the nonce was reserved and signed but the
+// process crashed before the HTTP response confirming the outcome was
+// processed). It queries info for fills in the last lookback duration up
+// to the high-water mark's timestamp and returns them so the caller can
+// reconcile local state before resuming order flow; it does not itself
+// mutate the stored high-water mark.
+func (m *NonceManager) RecoverFromCrash(
+	ctx context.Context,
+	info *Info,
+	address string,
+	lookback time.Duration,
+) ([]Fill, error) {
+	mark, err := m.store.Load(address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load nonce high-water mark: %w", err)
+	}
+	if mark == 0 {
+		return nil, nil
+	}
+
+	start := mark - lookback.Milliseconds()
+	end := mark
+	fills, err := info.UserFillsByTimeWithContext(ctx, address, start, &end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan fills for crash recovery: %w", err)
+	}
+	return fills, nil
+}
@@ -0,0 +1,134 @@
+package hyperliquid
+
+import (
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNonceManagerNextIsMonotonic(t *testing.T) {
+	m := NewNonceManager(nil)
+
+	var prev int64
+	for i := 0; i < 5; i++ {
+		n, err := m.Next("0xaa")
+		require.NoError(t, err)
+		assert.Greater(t, n, prev)
+		prev = n
+	}
+}
+
+func TestNonceManagerNextIsMonotonicUnderConcurrency(t *testing.T) {
+	m := NewNonceManager(nil)
+
+	const goroutines = 50
+	nonces := make([]int64, goroutines)
+
+	var wg sync.WaitGroup
+	wg.Add(goroutines)
+	for i := 0; i < goroutines; i++ {
+		i := i
+		go func() {
+			defer wg.Done()
+			n, err := m.Next("0xaa")
+			require.NoError(t, err)
+			nonces[i] = n
+		}()
+	}
+	wg.Wait()
+
+	seen := make(map[int64]bool, goroutines)
+	for _, n := range nonces {
+		assert.False(t, seen[n], "nonce %d issued twice", n)
+		seen[n] = true
+	}
+}
+
+func TestNonceManagerReserveAndRelease(t *testing.T) {
+	m := NewNonceManager(nil)
+
+	r, err := m.Reserve("0xaa", 3)
+	require.NoError(t, err)
+	assert.Len(t, r.Nonces(), 3)
+	assert.EqualValues(t, 1, m.Metrics.Reservations.Load())
+
+	require.NoError(t, r.Release())
+	assert.EqualValues(t, 1, m.Metrics.Rewinds.Load())
+
+	// After releasing, the next reservation starts from the same window.
+	r2, err := m.Reserve("0xaa", 1)
+	require.NoError(t, err)
+	assert.Equal(t, r.Nonces()[0], r2.Nonces()[0])
+}
+
+func TestNonceManagerReleaseCollision(t *testing.T) {
+	m := NewNonceManager(nil)
+
+	r1, err := m.Reserve("0xaa", 1)
+	require.NoError(t, err)
+	_, err = m.Reserve("0xaa", 1)
+	require.NoError(t, err)
+
+	err = r1.Release()
+	assert.Error(t, err)
+	assert.EqualValues(t, 1, m.Metrics.Collisions.Load())
+}
+
+func TestNonceManagerCommitIsNoop(t *testing.T) {
+	m := NewNonceManager(nil)
+	r, err := m.Reserve("0xaa", 1)
+	require.NoError(t, err)
+	r.Commit()
+	assert.Error(t, r.Release())
+}
+
+func TestFileNonceStorePersistsAcrossInstances(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nonces.json")
+
+	store1 := NewFileNonceStore(path)
+	require.NoError(t, store1.Save("0xaa", 42))
+
+	store2 := NewFileNonceStore(path)
+	n, err := store2.Load("0xaa")
+	require.NoError(t, err)
+	assert.EqualValues(t, 42, n)
+}
+
+func TestNonceManagerUsesStoredHighWaterMark(t *testing.T) {
+	store := NewMemoryNonceStore()
+	future := time.Now().Add(24 * time.Hour).UnixMilli()
+	require.NoError(t, store.Save("0xaa", future))
+
+	m := NewNonceManager(store)
+	n, err := m.Next("0xaa")
+	require.NoError(t, err)
+	assert.Equal(t, future+1, n)
+}
+
+func TestClampNonceToWindow(t *testing.T) {
+	now := time.Now().UnixMilli()
+
+	tooOld := now - 3*24*time.Hour.Milliseconds()
+	assert.Equal(t, now-nonceWindowPast.Milliseconds(), clampNonceToWindow(tooOld, now))
+
+	tooNew := now + 2*24*time.Hour.Milliseconds()
+	assert.Equal(t, now+nonceWindowFuture.Milliseconds(), clampNonceToWindow(tooNew, now))
+
+	inRange := now - time.Hour.Milliseconds()
+	assert.Equal(t, inRange, clampNonceToWindow(inRange, now))
+}
+
+func TestNonceManagerClampsStaleHighWaterMark(t *testing.T) {
+	store := NewMemoryNonceStore()
+	stale := time.Now().Add(-3 * 24 * time.Hour).UnixMilli()
+	require.NoError(t, store.Save("0xaa", stale))
+
+	m := NewNonceManager(store)
+	n, err := m.Next("0xaa")
+	require.NoError(t, err)
+	assert.Greater(t, n, stale)
+}
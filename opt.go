@@ -2,7 +2,9 @@ package hyperliquid
 
 import (
 	"os"
+	"time"
 
+	"github.com/sonirico/go-hyperliquid/cienv"
 	"github.com/sonirico/vago/lol"
 )
 
@@ -30,18 +32,212 @@ func WsOptDebugMode() WsOpt {
 	}
 }
 
+// WsOptOnReconnect registers fn to be called after every reconnect dial
+// attempt, successful or not, with the 1-indexed attempt number and the
+// dial error (nil on success). Use it to log or alert on flapping
+// connections.
+func WsOptOnReconnect(fn func(attempt int, err error)) WsOpt {
+	return func(w *WebsocketClient) {
+		w.onReconnect = fn
+	}
+}
+
+// WsOptMaxReconnectAttempts bounds how many times reconnect redials before
+// giving up, instead of retrying forever. n <= 0 means unlimited, the
+// default.
+func WsOptMaxReconnectAttempts(n int) WsOpt {
+	return func(w *WebsocketClient) {
+		w.maxReconnectAttempts = n
+	}
+}
+
+// WsOptReconnectBackoff overrides reconnect's jittered exponential backoff
+// bounds, which otherwise start at 250ms and cap at 30s.
+func WsOptReconnectBackoff(base, max time.Duration) WsOpt {
+	return func(w *WebsocketClient) {
+		w.reconnectBaseDelay = base
+		w.reconnectMaxDelay = max
+	}
+}
+
 func InfoOptDebugMode() InfoOpt {
 	return func(i *Info) {
 		i.debug = true
 	}
 }
 
+// WithRequestMethod configures how Info issues requests to every Info
+// endpoint: MethodPOSTWithFallback retries once as a GET (payload encoded
+// as a query parameter) when the POST response status is in
+// fallbackStatuses (405 and 404 by default), the same fallback the
+// Prometheus Go client's Query/QueryRange use. This applies to every Info
+// endpoint helper automatically, since they all post through the same
+// underlying client.
+func WithRequestMethod(method RequestMethod, fallbackStatuses ...int) InfoOpt {
+	return func(i *Info) {
+		i.requestMethod = method
+		i.requestMethodFallback = fallbackStatuses
+	}
+}
+
+// WithMetrics attaches sink to Info and its underlying client, so every
+// RPC records request count/outcome, latency, retries, and rate-limit
+// hits, and PerpDexLimits/PerpDexStatus/PerpDeployAuctionStatus populate
+// sink's builder-dex gauges. Absent this option, Info uses a no-op sink
+// with zero overhead. Use NewPrometheusMetricsSink to back this with
+// Prometheus, or implement MetricsSink directly for OpenTelemetry/statsd.
+func WithMetrics(sink MetricsSink) InfoOpt {
+	return func(i *Info) {
+		i.metrics = sink
+	}
+}
+
+// WithRequestCoalescing deduplicates concurrent identical requests issued
+// through Info: if a call for the same endpoint and payload (type, user,
+// coin, time bounds, ...) is already in flight, a later caller waits for
+// that call's result instead of issuing its own HTTP round trip. This
+// applies to every Info endpoint helper automatically, since they all
+// post through Info.post. A caller's ctx cancellation only ever unblocks
+// that caller - it never cancels the shared in-flight request, which
+// keeps running for whichever caller is still waiting on it. See
+// info_coalesce.go.
+func WithRequestCoalescing() InfoOpt {
+	return func(i *Info) {
+		i.coalesce = newRequestCoalescer()
+	}
+}
+
+// WithRecorder makes Info persist every call's (payload, response) pair
+// as a JSON fixture under dir, one subdirectory per endpoint
+// (dir/meta/<hash>.json, dir/userFills/<hash>.json, ...), keyed by
+// coalesceKey's canonical hash of the call's path+payload. Pair a
+// recording run against live Hyperliquid with WithReplay(dir) in tests,
+// so parsing code (parseMetaResponse, the array-tuple decoders, ...) can
+// be exercised deterministically and a recorded corpus re-played to
+// catch a response shape regression without hitting the network. See
+// info_fixture.go.
+func WithRecorder(dir string) InfoOpt {
+	return func(i *Info) {
+		i.recordDir = dir
+	}
+}
+
+// WithReplay makes Info short out every call with a fixture previously
+// captured by WithRecorder(dir) instead of issuing it over the network,
+// failing loudly on a cache miss rather than silently falling back to a
+// live request. WithReplay takes precedence over WithRecorder and
+// WithRequestCoalescing when both are attached to the same Info. See
+// info_fixture.go.
+func WithReplay(dir string) InfoOpt {
+	return func(i *Info) {
+		i.replayDir = dir
+	}
+}
+
+// WithMetaRefresh configures Info's background asset-index refresher
+// (see info_refresh.go): a goroutine started by NewInfo/NewInfoWithContext
+// that re-fetches Meta/SpotMeta on cfg.Interval and hot-swaps
+// coinToAsset/nameToCoin/assetToDecimal/tokenIndexToName, so a long-
+// running process picks up new listings and szDecimals changes without
+// restarting. A zero cfg.Interval (the default) disables the refresher.
+// Subscribe for notification of what each refresh changed.
+func WithMetaRefresh(cfg MetaRefreshConfig) InfoOpt {
+	return func(i *Info) {
+		i.refreshConfig = cfg
+	}
+}
+
+// ExchangeOptMetrics is WithMetrics for Exchange: it attaches sink to both
+// Exchange's underlying client and the Info instance NewExchange builds
+// alongside it.
+func ExchangeOptMetrics(sink MetricsSink) ExchangeOpt {
+	return func(e *Exchange) {
+		e.metrics = sink
+	}
+}
+
 func ExchangeOptDebugMode() ExchangeOpt {
 	return func(e *Exchange) {
 		e.debug = true
 	}
 }
 
+// ExchangeOptSigner configures the Exchange to sign actions through signer
+// instead of the raw *ecdsa.PrivateKey passed to NewExchange, enabling KMS,
+// hardware wallets, or remote signers.
+func ExchangeOptSigner(signer Signer) ExchangeOpt {
+	return func(e *Exchange) {
+		e.signer = signer
+	}
+}
+
+// ExchangeOptNonceManager configures the Exchange to allocate nonces
+// through manager instead of the built-in timestamp counter, enabling
+// reservation/rollback and cross-restart persistence for concurrent order
+// submission.
+func ExchangeOptNonceManager(manager *NonceManager) ExchangeOpt {
+	return func(e *Exchange) {
+		e.nonceManager = manager
+	}
+}
+
+// ExchangeOptBridgeRouter configures the Exchange to route
+// Exchange.WithdrawToChain and Exchange.ResumeWithdrawal calls through
+// router, forwarding Hyperliquid bridge withdrawals on to a downstream
+// chain.
+func ExchangeOptBridgeRouter(router *BridgeRouter) ExchangeOpt {
+	return func(e *Exchange) {
+		e.bridgeRouter = router
+	}
+}
+
+// ExchangeOptDryRun configures the Exchange so every action is signed as
+// normal but never POSTed: the fully-signed SignedAction envelope is handed
+// to sink instead, and is also what postAction returns as the action's raw
+// response (so dry-run calls do not panic their caller's json.Unmarshal,
+// though they will decode the envelope shape rather than a real API
+// response). Use this for governance/validator operators who must review or
+// air-gap-sign privileged actions, or for CI pipelines snapshotting
+// canonical signed payloads. Pair with Exchange.SubmitSigned to post the
+// envelope later.
+func ExchangeOptDryRun(sink func(SignedAction)) ExchangeOpt {
+	return func(e *Exchange) {
+		e.dryRunSink = sink
+	}
+}
+
+// ExchangeOptOfflineSigner configures the Exchange to sign every action
+// without POSTing it, the same as ExchangeOptDryRun but without requiring a
+// sink: callers that only want the returned SignedAction bytes (e.g. to
+// persist and submit from an air-gapped machine later) can use this
+// instead.
+func ExchangeOptOfflineSigner() ExchangeOpt {
+	return func(e *Exchange) {
+		e.offlineSigner = true
+	}
+}
+
+// ExchangeOptCIMetadata tags every order this Exchange places with a
+// deterministic ClientOrderID prefix derived from info.CommitSHA (see
+// exchange_ci.go), so orders placed by a CI run are auditable on-chain back
+// to the build that placed them. info is typically cienv.Detect()'s result;
+// a zero BuildInfo (outside CI) leaves order submission unchanged.
+func ExchangeOptCIMetadata(info cienv.BuildInfo) ExchangeOpt {
+	return func(e *Exchange) {
+		e.ciInfo = info
+	}
+}
+
+// WsOptCIMetadata tags this WebsocketClient's subscribe/unsubscribe log
+// lines with info's provider and build URL, so subscriptions made during a
+// CI run can be traced back to the build that made them. info is typically
+// cienv.Detect()'s result; a zero BuildInfo (outside CI) disables tagging.
+func WsOptCIMetadata(info cienv.BuildInfo) WsOpt {
+	return func(w *WebsocketClient) {
+		w.ciInfo = info
+	}
+}
+
 func ClientOptDebugMode() ClientOpt {
 	return func(c *Client) {
 		c.debug = true
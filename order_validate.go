@@ -0,0 +1,229 @@
+package hyperliquid
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// perpPriceDecimals is Hyperliquid's fixed maximum price decimals for
+// perps (8 for spot, not handled here since ValidateOrder checks against
+// MetaAndAssetCtxs' perp universe); see tickLotDecimals for the same
+// constant used by Exchange's order rounding.
+const perpPriceDecimals = 6
+
+// OrderValidationCode classifies one OrderValidationError, so callers can
+// switch on the failure instead of matching Message text.
+type OrderValidationCode int
+
+const (
+	// OrderValidationUnknownCoin means the order's Coin is not in the
+	// queried dex's universe.
+	OrderValidationUnknownCoin OrderValidationCode = iota
+	// OrderValidationSizeDecimals means Size has more decimal places than
+	// the asset's SzDecimals allows.
+	OrderValidationSizeDecimals
+	// OrderValidationPriceDecimals means Price has more decimal places
+	// than the asset's price-decimals (perpPriceDecimals - SzDecimals)
+	// allows.
+	OrderValidationPriceDecimals
+	// OrderValidationLeverageExceeded means Leverage exceeds the
+	// MaxLeverage of the MarginTable tier matching the order's notional.
+	OrderValidationLeverageExceeded
+	// OrderValidationPriceOutOfBand means Price deviates from the asset's
+	// MarkPx by more than OrderValidatorConfig.MaxPriceBandPct.
+	OrderValidationPriceOutOfBand
+)
+
+// OrderValidationError is one check Info.ValidateOrder failed.
+type OrderValidationError struct {
+	Code    OrderValidationCode
+	Message string
+}
+
+func (e *OrderValidationError) Error() string {
+	return e.Message
+}
+
+// OrderValidationErrors is every check Info.ValidateOrder failed against a
+// proposed order. It is always returned as this typed slice rather than a
+// plain error, so a trading bot can range over it and switch on each
+// entry's Code instead of parsing one combined message.
+type OrderValidationErrors []*OrderValidationError
+
+func (errs OrderValidationErrors) Error() string {
+	messages := make([]string, len(errs))
+	for i, err := range errs {
+		messages[i] = err.Message
+	}
+	return fmt.Sprintf("order validation failed: %s", strings.Join(messages, "; "))
+}
+
+// OrderValidationRequest is the proposed order Info.ValidateOrder checks
+// against the cached Meta/MetaAndAssetCtxs for Dex, mirroring the checks
+// Hyperliquid's own mempool performs on an incoming order.
+type OrderValidationRequest struct {
+	Coin       string
+	IsBuy      bool
+	Price      float64
+	Size       float64
+	Leverage   int
+	ReduceOnly bool
+	Tif        string
+	// Dex is the perp dex to validate against ("" for the default dex),
+	// the same convention MetaAndAssetCtxsForDex uses.
+	Dex string
+}
+
+// OrderValidatorConfig tunes Info.ValidateOrder's mark-price band check.
+type OrderValidatorConfig struct {
+	// MaxPriceBandPct bounds how far Price may sit from the asset's
+	// MarkPx, as a fraction of MarkPx (0.1 == 10%). Zero disables the
+	// check.
+	MaxPriceBandPct float64
+}
+
+// ValidateOrder checks req against the Meta/MetaAndAssetCtxs for req.Dex
+// (served from Info.WithMetaCache when attached, otherwise fetched fresh):
+// that req.Coin exists in the universe, that req.Size conforms to the
+// asset's SzDecimals, that req.Price conforms to the asset's derived
+// price-decimals, that req.Leverage does not exceed the MaxLeverage of the
+// MarginTable tier matching the order's notional (req.Price * req.Size),
+// and that req.Price sits within cfg.MaxPriceBandPct of the asset's
+// MarkPx. It returns every violation found as OrderValidationErrors, not
+// just the first, and nil when req passes every check. An unknown coin
+// short-circuits the remaining checks, since none of them have an asset to
+// check against.
+func (i *Info) ValidateOrder(ctx context.Context, req OrderValidationRequest, cfg OrderValidatorConfig) error {
+	var mac *MetaAndAssetCtxs
+	var err error
+	if req.Dex == "" {
+		mac, err = i.MetaAndAssetCtxsWithContext(ctx)
+	} else {
+		mac, err = i.MetaAndAssetCtxsForDexWithContext(ctx, req.Dex)
+	}
+	if err != nil {
+		return fmt.Errorf("validate order: fetch meta and asset ctxs: %w", err)
+	}
+
+	idx := -1
+	for j, assetInfo := range mac.Meta.Universe {
+		if assetInfo.Name == req.Coin {
+			idx = j
+			break
+		}
+	}
+	if idx == -1 {
+		return OrderValidationErrors{{
+			Code:    OrderValidationUnknownCoin,
+			Message: fmt.Sprintf("coin %q not found in universe", req.Coin),
+		}}
+	}
+	assetInfo := mac.Meta.Universe[idx]
+
+	var errs OrderValidationErrors
+
+	if !conformsToDecimals(req.Size, assetInfo.SzDecimals) {
+		errs = append(errs, &OrderValidationError{
+			Code:    OrderValidationSizeDecimals,
+			Message: fmt.Sprintf("size %v has more than %d decimal places", req.Size, assetInfo.SzDecimals),
+		})
+	}
+
+	priceDecimals := perpPriceDecimals - assetInfo.SzDecimals
+	if !conformsToDecimals(req.Price, priceDecimals) {
+		errs = append(errs, &OrderValidationError{
+			Code:    OrderValidationPriceDecimals,
+			Message: fmt.Sprintf("price %v has more than %d decimal places", req.Price, priceDecimals),
+		})
+	}
+
+	if req.Leverage > 0 {
+		if tier, ok := marginTierForNotional(mac.Meta.MarginTables, assetInfo.MarginTableId, req.Price*req.Size); ok && req.Leverage > tier.MaxLeverage {
+			errs = append(errs, &OrderValidationError{
+				Code:    OrderValidationLeverageExceeded,
+				Message: fmt.Sprintf("leverage %dx exceeds %dx max for notional %.2f", req.Leverage, tier.MaxLeverage, req.Price*req.Size),
+			})
+		}
+	}
+
+	if cfg.MaxPriceBandPct > 0 && idx < len(mac.Ctxs) {
+		if markPx := parseFloat(mac.Ctxs[idx].MarkPx); markPx > 0 {
+			if deviation := math.Abs(req.Price-markPx) / markPx; deviation > cfg.MaxPriceBandPct {
+				errs = append(errs, &OrderValidationError{
+					Code:    OrderValidationPriceOutOfBand,
+					Message: fmt.Sprintf("price %v is %.2f%% from mark price %v, exceeding %.2f%% band", req.Price, deviation*100, markPx, cfg.MaxPriceBandPct*100),
+				})
+			}
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// conformsToDecimals reports whether v already sits on an allowed
+// decimals-place boundary, i.e. rounding it to decimals places changes
+// nothing beyond float64 rounding error.
+func conformsToDecimals(v float64, decimals int) bool {
+	if decimals < 0 {
+		decimals = 0
+	}
+	return math.Abs(v-roundToDecimals(v, decimals)) < 1e-9
+}
+
+// marginTierForNotional returns the MarginTier in tables' entry with ID
+// tableID whose LowerBound is the greatest one not exceeding notional, the
+// same tier selection Hyperliquid's mempool uses to pick a position's
+// applicable max leverage. ok is false when tableID has no matching table
+// or the table has no tier at or below notional.
+func marginTierForNotional(tables []MarginTable, tableID int, notional float64) (tier MarginTier, ok bool) {
+	for _, table := range tables {
+		if table.ID != tableID {
+			continue
+		}
+
+		tiers := append([]MarginTier(nil), table.MarginTiers...)
+		sort.Slice(tiers, func(a, b int) bool {
+			return parseFloat(tiers[a].LowerBound) < parseFloat(tiers[b].LowerBound)
+		})
+
+		for _, candidate := range tiers {
+			if parseFloat(candidate.LowerBound) > notional {
+				break
+			}
+			tier, ok = candidate, true
+		}
+		return tier, ok
+	}
+	return MarginTier{}, false
+}
+
+// OrderWithValidate runs Info.ValidateOrder against req (with leverage and
+// cfg layered on top, since CreateOrderRequest carries neither) before
+// signing and submitting, returning the OrderValidationErrors instead of
+// placing the order when any check fails.
+func (e *Exchange) OrderWithValidate(
+	ctx context.Context,
+	req CreateOrderRequest,
+	leverage int,
+	cfg OrderValidatorConfig,
+	builder *BuilderInfo,
+) (OrderStatus, error) {
+	validationReq := OrderValidationRequest{
+		Coin:       req.Coin,
+		IsBuy:      req.IsBuy,
+		Price:      req.Price,
+		Size:       req.Size,
+		Leverage:   leverage,
+		ReduceOnly: req.ReduceOnly,
+	}
+	if err := e.info.ValidateOrder(ctx, validationReq, cfg); err != nil {
+		return OrderStatus{}, err
+	}
+	return e.Order(ctx, req, builder)
+}
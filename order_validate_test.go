@@ -0,0 +1,143 @@
+package hyperliquid
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testValidatorInfo(mac *MetaAndAssetCtxs) *Info {
+	info := &Info{}
+	mc := newMetaCache(info, MetaCacheConfig{TTL: time.Hour})
+	mc.entries[metaCacheKeyAssetCtxs("")] = &metaCacheEntry{value: mac, expiresAt: time.Now().Add(time.Hour)}
+	info.metaCache = mc
+	return info
+}
+
+// testValidatorMeta mirrors the BTC/ETH fixture data TestMetaAndAssetCtxs
+// asserts against its cassette: BTC has SzDecimals 5/MaxLeverage 40/
+// MarginTableId 56, ETH has SzDecimals 4/MaxLeverage 25/MarginTableId 55.
+func testValidatorMeta() *MetaAndAssetCtxs {
+	return &MetaAndAssetCtxs{
+		Meta: Meta{
+			Universe: []AssetInfo{
+				{Name: "BTC", SzDecimals: 5, MaxLeverage: 40, MarginTableId: 56},
+				{Name: "ETH", SzDecimals: 4, MaxLeverage: 25, MarginTableId: 55},
+			},
+			MarginTables: []MarginTable{
+				{ID: 56, MarginTiers: []MarginTier{
+					{LowerBound: "0", MaxLeverage: 40},
+					{LowerBound: "150000", MaxLeverage: 20},
+				}},
+				{ID: 55, MarginTiers: []MarginTier{
+					{LowerBound: "0", MaxLeverage: 25},
+				}},
+			},
+		},
+		Ctxs: []AssetCtx{
+			{MarkPx: "60000"},
+			{MarkPx: "3000"},
+		},
+	}
+}
+
+func TestValidateOrderAcceptsConformingOrder(t *testing.T) {
+	info := testValidatorInfo(testValidatorMeta())
+
+	err := info.ValidateOrder(context.Background(), OrderValidationRequest{
+		Coin:     "BTC",
+		IsBuy:    true,
+		Price:    60000.1,
+		Size:     1.12345,
+		Leverage: 10,
+	}, OrderValidatorConfig{MaxPriceBandPct: 0.1})
+
+	assert.NoError(t, err)
+}
+
+func TestValidateOrderRejectsUnknownCoin(t *testing.T) {
+	info := testValidatorInfo(testValidatorMeta())
+
+	err := info.ValidateOrder(context.Background(), OrderValidationRequest{Coin: "DOGE", Price: 1, Size: 1}, OrderValidatorConfig{})
+
+	var errs OrderValidationErrors
+	require.True(t, errors.As(err, &errs))
+	require.Len(t, errs, 1)
+	assert.Equal(t, OrderValidationUnknownCoin, errs[0].Code)
+}
+
+func TestValidateOrderRejectsSizeDecimalsOverflow(t *testing.T) {
+	info := testValidatorInfo(testValidatorMeta())
+
+	err := info.ValidateOrder(context.Background(), OrderValidationRequest{
+		Coin: "BTC", Price: 60000.1, Size: 1.123456,
+	}, OrderValidatorConfig{})
+
+	var errs OrderValidationErrors
+	require.True(t, errors.As(err, &errs))
+	assert.Contains(t, codesOf(errs), OrderValidationSizeDecimals)
+}
+
+func TestValidateOrderRejectsPriceDecimalsOverflow(t *testing.T) {
+	info := testValidatorInfo(testValidatorMeta())
+
+	err := info.ValidateOrder(context.Background(), OrderValidationRequest{
+		Coin: "BTC", Price: 60000.12, Size: 1.12345,
+	}, OrderValidatorConfig{})
+
+	var errs OrderValidationErrors
+	require.True(t, errors.As(err, &errs))
+	assert.Contains(t, codesOf(errs), OrderValidationPriceDecimals)
+}
+
+func TestValidateOrderRejectsLeverageExceedingTier(t *testing.T) {
+	info := testValidatorInfo(testValidatorMeta())
+
+	// Notional well above the 150000 tier boundary, so only 20x is allowed.
+	err := info.ValidateOrder(context.Background(), OrderValidationRequest{
+		Coin: "BTC", Price: 60000, Size: 10, Leverage: 30,
+	}, OrderValidatorConfig{})
+
+	var errs OrderValidationErrors
+	require.True(t, errors.As(err, &errs))
+	assert.Contains(t, codesOf(errs), OrderValidationLeverageExceeded)
+}
+
+func TestValidateOrderRejectsPriceOutsideMarkBand(t *testing.T) {
+	info := testValidatorInfo(testValidatorMeta())
+
+	err := info.ValidateOrder(context.Background(), OrderValidationRequest{
+		Coin: "BTC", Price: 70000, Size: 1,
+	}, OrderValidatorConfig{MaxPriceBandPct: 0.1})
+
+	var errs OrderValidationErrors
+	require.True(t, errors.As(err, &errs))
+	assert.Contains(t, codesOf(errs), OrderValidationPriceOutOfBand)
+}
+
+func codesOf(errs OrderValidationErrors) []OrderValidationCode {
+	codes := make([]OrderValidationCode, len(errs))
+	for i, e := range errs {
+		codes[i] = e.Code
+	}
+	return codes
+}
+
+func TestMarginTierForNotionalPicksHighestTierAtOrBelowNotional(t *testing.T) {
+	tables := testValidatorMeta().Meta.MarginTables
+
+	tier, ok := marginTierForNotional(tables, 56, 200000)
+	require.True(t, ok)
+	assert.Equal(t, 20, tier.MaxLeverage)
+
+	tier, ok = marginTierForNotional(tables, 56, 1000)
+	require.True(t, ok)
+	assert.Equal(t, 40, tier.MaxLeverage)
+
+	_, ok = marginTierForNotional(tables, 999, 1000)
+	assert.False(t, ok)
+}
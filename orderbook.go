@@ -0,0 +1,257 @@
+package hyperliquid
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// orderBookStaleAfter is how long an OrderBook will wait between l2Book
+// snapshots before assuming the subscription has silently stalled (e.g. the
+// socket dropped frames without closing) and resubscribing.
+const orderBookStaleAfter = 10 * time.Second
+
+// OrderBook maintains a local view of a coin's order book by merging
+// streamed l2Book snapshots with bbo updates used for cross-verification.
+// It detects subscription gaps via L2Book.Time staleness and automatically
+// resubscribes to recover.
+//
+// The server sends full, pre-sorted book snapshots rather than incremental
+// diffs, so levels are stored as plain slices rather than a tree: there is
+// no per-level upsert to accelerate, only a full replace on every message.
+// Diff is the hook for callers that want to react to level changes instead
+// of full snapshots.
+type OrderBook struct {
+	ws   *WebsocketClient
+	coin string
+
+	mu         sync.RWMutex
+	bids       []Level
+	asks       []Level
+	lastTime   int64
+	lastUpdate time.Time
+	bbo        []Level
+
+	done chan struct{}
+
+	// Diff, if set, is called with the full replacement snapshot every time
+	// a new l2Book message is applied.
+	Diff func(bids, asks []Level)
+}
+
+// SubscribeOrderBook subscribes to l2Book and bbo for coin and returns an
+// OrderBook that keeps itself up to date until Close is called.
+func (w *WebsocketClient) SubscribeOrderBook(coin string) (*OrderBook, error) {
+	ob := &OrderBook{
+		ws:   w,
+		coin: coin,
+		done: make(chan struct{}),
+	}
+
+	if err := ob.subscribe(); err != nil {
+		return nil, err
+	}
+
+	go ob.watchForGaps()
+
+	return ob, nil
+}
+
+func (ob *OrderBook) subscribe() error {
+	if _, err := ob.ws.L2Book(L2BookSubscriptionParams{Coin: ob.coin}, ob.onL2Book); err != nil {
+		return fmt.Errorf("subscribe l2Book: %w", err)
+	}
+
+	if _, err := ob.ws.Bbo(BboSubscriptionParams{Coin: ob.coin}, ob.onBbo); err != nil {
+		return fmt.Errorf("subscribe bbo: %w", err)
+	}
+
+	return nil
+}
+
+// Close stops the gap-detection watchdog. It does not tear down the
+// underlying WebsocketClient subscriptions.
+func (ob *OrderBook) Close() {
+	select {
+	case <-ob.done:
+	default:
+		close(ob.done)
+	}
+}
+
+func (ob *OrderBook) watchForGaps() {
+	ticker := time.NewTicker(orderBookStaleAfter)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ob.done:
+			return
+		case <-ticker.C:
+			ob.mu.RLock()
+			stale := !ob.lastUpdate.IsZero() && time.Since(ob.lastUpdate) > orderBookStaleAfter
+			ob.mu.RUnlock()
+
+			if stale {
+				_ = ob.subscribe()
+			}
+		}
+	}
+}
+
+func (ob *OrderBook) onL2Book(book L2Book, err error) {
+	if err != nil {
+		return
+	}
+
+	ob.mu.Lock()
+	if book.Time != 0 && book.Time <= ob.lastTime {
+		// Stale or out-of-order snapshot; drop it rather than regress state.
+		ob.mu.Unlock()
+		return
+	}
+
+	var bids, asks []Level
+	if len(book.Levels) > 0 {
+		bids = book.Levels[0]
+	}
+	if len(book.Levels) > 1 {
+		asks = book.Levels[1]
+	}
+
+	ob.bids = bids
+	ob.asks = asks
+	ob.lastTime = book.Time
+	ob.lastUpdate = time.Now()
+	diff := ob.Diff
+	ob.mu.Unlock()
+
+	if diff != nil {
+		diff(bids, asks)
+	}
+}
+
+func (ob *OrderBook) onBbo(bbo Bbo, err error) {
+	if err != nil {
+		return
+	}
+
+	ob.mu.Lock()
+	ob.bbo = bbo.Bbo
+	ob.mu.Unlock()
+}
+
+// BestBidAsk returns the current best bid and best ask levels.
+func (ob *OrderBook) BestBidAsk() (bid Level, ask Level) {
+	ob.mu.RLock()
+	defer ob.mu.RUnlock()
+
+	if len(ob.bids) > 0 {
+		bid = ob.bids[0]
+	}
+	if len(ob.asks) > 0 {
+		ask = ob.asks[0]
+	}
+	return bid, ask
+}
+
+// Depth returns up to n levels on the given side ("B" for bids, "A" for
+// asks), best level first.
+func (ob *OrderBook) Depth(side string, n int) []Level {
+	ob.mu.RLock()
+	defer ob.mu.RUnlock()
+
+	var levels []Level
+	switch side {
+	case "B":
+		levels = ob.bids
+	case "A":
+		levels = ob.asks
+	default:
+		return nil
+	}
+
+	if n > len(levels) {
+		n = len(levels)
+	}
+
+	out := make([]Level, n)
+	copy(out, levels[:n])
+	return out
+}
+
+// MidPrice returns the midpoint between the best bid and best ask, or zero
+// if either side is currently empty.
+func (ob *OrderBook) MidPrice() float64 {
+	bid, ask := ob.BestBidAsk()
+	if bid.Px == 0 || ask.Px == 0 {
+		return 0
+	}
+	return (bid.Px + ask.Px) / 2
+}
+
+// VWAP returns the size-weighted average price to fill size on the given
+// side ("B" or "A"), walking the book outward from the best level. It
+// returns an error if the maintained book does not have enough depth.
+func (ob *OrderBook) VWAP(side string, size float64) (float64, error) {
+	levels := ob.Depth(side, ob.depthLen(side))
+
+	remaining := size
+	var notional float64
+
+	for _, lvl := range levels {
+		fill := lvl.Sz
+		if fill > remaining {
+			fill = remaining
+		}
+		notional += fill * lvl.Px
+		remaining -= fill
+		if remaining <= 0 {
+			break
+		}
+	}
+
+	if remaining > 0 {
+		return 0, fmt.Errorf("insufficient depth on side %q to fill size %v", side, size)
+	}
+
+	return notional / size, nil
+}
+
+func (ob *OrderBook) depthLen(side string) int {
+	ob.mu.RLock()
+	defer ob.mu.RUnlock()
+
+	switch side {
+	case "B":
+		return len(ob.bids)
+	case "A":
+		return len(ob.asks)
+	default:
+		return 0
+	}
+}
+
+// VerifyAgainstBbo cross-checks the maintained book's best bid/ask against
+// the most recently received bbo message, returning an error when they
+// disagree, which indicates the locally maintained book has desynced.
+func (ob *OrderBook) VerifyAgainstBbo() error {
+	ob.mu.RLock()
+	bbo := ob.bbo
+	ob.mu.RUnlock()
+
+	if len(bbo) == 0 {
+		return nil
+	}
+
+	bid, ask := ob.BestBidAsk()
+
+	if bbo[0].Px != bid.Px {
+		return fmt.Errorf("order book desynced: bbo bid %v != book bid %v", bbo[0].Px, bid.Px)
+	}
+	if len(bbo) > 1 && bbo[1].Px != ask.Px {
+		return fmt.Errorf("order book desynced: bbo ask %v != book ask %v", bbo[1].Px, ask.Px)
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package hyperliquid
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Signer abstracts over where private key material lives. A raw
+// *ecdsa.PrivateKey held in process memory is only one possible
+// implementation; this interface also allows AWS/GCP KMS, Ledger/Trezor,
+// Fireblocks, or any other remote signing service to produce Hyperliquid
+// signatures without the key ever entering this process.
+type Signer interface {
+	// Address returns the address SignHash produces signatures for.
+	Address() common.Address
+	// SignHash signs a 32-byte digest and returns it in r/s/v form.
+	SignHash(ctx context.Context, hash []byte) (SignatureResult, error)
+}
+
+// LocalSigner signs using an in-process *ecdsa.PrivateKey. It is the
+// default Signer and backs every exported Sign*Action function that still
+// takes a *ecdsa.PrivateKey directly.
+type LocalSigner struct {
+	privateKey *ecdsa.PrivateKey
+}
+
+// NewLocalSigner wraps privateKey as a Signer.
+func NewLocalSigner(privateKey *ecdsa.PrivateKey) *LocalSigner {
+	return &LocalSigner{privateKey: privateKey}
+}
+
+func (s *LocalSigner) Address() common.Address {
+	return crypto.PubkeyToAddress(s.privateKey.PublicKey)
+}
+
+func (s *LocalSigner) SignHash(_ context.Context, hash []byte) (SignatureResult, error) {
+	signature, err := crypto.Sign(hash, s.privateKey)
+	if err != nil {
+		return SignatureResult{}, fmt.Errorf("failed to sign message: %w", err)
+	}
+
+	r := new(big.Int).SetBytes(signature[:32])
+	sVal := new(big.Int).SetBytes(signature[32:64])
+	v := int(signature[64]) + 27
+
+	return SignatureResult{
+		R: hexutil.EncodeBig(r),
+		S: hexutil.EncodeBig(sVal),
+		V: v,
+	}, nil
+}
+
+// RemoteSigner delegates signing to an HTTP endpoint. It POSTs
+// {"hash": "0x...", "domain": "..."} and expects a SignatureResult
+// ({"r", "s", "v"}) JSON body back. This is the integration point for a
+// small bridge service fronting a hardware wallet or remote KMS key.
+type RemoteSigner struct {
+	address    common.Address
+	url        string
+	domain     string
+	httpClient *http.Client
+}
+
+// NewRemoteSigner creates a RemoteSigner that POSTs signing requests to
+// url on behalf of address. domain is an opaque label (e.g. "l1" or
+// "user-signed") forwarded with every request so the remote service can
+// apply its own policy/display logic per signing context.
+func NewRemoteSigner(address common.Address, url, domain string) *RemoteSigner {
+	return &RemoteSigner{
+		address:    address,
+		url:        url,
+		domain:     domain,
+		httpClient: new(http.Client),
+	}
+}
+
+func (s *RemoteSigner) Address() common.Address {
+	return s.address
+}
+
+func (s *RemoteSigner) SignHash(ctx context.Context, hash []byte) (SignatureResult, error) {
+	body, err := json.Marshal(map[string]string{
+		"hash":   hexutil.Encode(hash),
+		"domain": s.domain,
+	})
+	if err != nil {
+		return SignatureResult{}, fmt.Errorf("failed to marshal remote sign request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return SignatureResult{}, fmt.Errorf("failed to create remote sign request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return SignatureResult{}, fmt.Errorf("remote sign request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= httpErrorStatusCode {
+		return SignatureResult{}, fmt.Errorf("remote signer returned status %d", resp.StatusCode)
+	}
+
+	var result SignatureResult
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return SignatureResult{}, fmt.Errorf("failed to decode remote sign response: %w", err)
+	}
+	return result, nil
+}
+
+// KMSSigner is the extension point for external KMS adapters (AWS KMS,
+// GCP KMS, Fireblocks, ...). It is identical to Signer; it exists as a
+// separate, documented name so adapters can target it explicitly without
+// depending on the more generic Signer name.
+type KMSSigner interface {
+	Signer
+}
+
+// AgentSigner wraps an approved agent wallet's private key (as returned
+// by Exchange.ApproveAgent) so it can be passed to ExchangeOptSigner in
+// place of the master account's key. An agent can sign L1 actions on the
+// master account's behalf but cannot itself approve further agents or
+// withdraw funds, so swapping ExchangeOptSigner(NewAgentSigner(...)) in
+// lets a long-running bot trade without holding the master key in
+// process memory.
+type AgentSigner struct {
+	*LocalSigner
+}
+
+// NewAgentSigner wraps an agent's private key (hex-encoded, with or
+// without the "0x" prefix, the same format Exchange.ApproveAgent
+// returns) as a Signer.
+func NewAgentSigner(agentPrivateKeyHex string) (*AgentSigner, error) {
+	agentPrivateKeyHex = strings.TrimPrefix(agentPrivateKeyHex, "0x")
+
+	privateKey, err := crypto.HexToECDSA(agentPrivateKeyHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid agent private key: %w", err)
+	}
+
+	return &AgentSigner{LocalSigner: NewLocalSigner(privateKey)}, nil
+}
@@ -0,0 +1,143 @@
+package hyperliquid
+
+import (
+	"context"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// secp256k1N and secp256k1HalfN are the curve order and its half, used to
+// normalize KMS-produced signatures to Ethereum's canonical low-S form and
+// to brute-force the recovery id KMS services don't return.
+var (
+	secp256k1N, _  = new(big.Int).SetString("fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141", 16)
+	secp256k1HalfN = new(big.Int).Rsh(secp256k1N, 1)
+)
+
+// derECDSASignature is the ASN.1 structure AWS KMS and GCP Cloud KMS both
+// return for an ECC_SECG_P256K1/EC_SIGN_SECP256K1_SHA256 Sign call.
+type derECDSASignature struct {
+	R, S *big.Int
+}
+
+// signatureResultFromDER parses a DER-encoded ECDSA signature, normalizes S
+// to the lower half of the curve order as Ethereum requires, and recovers
+// which of the two possible recovery ids (v) yields expected — KMS services
+// sign a digest but don't return v themselves.
+func signatureResultFromDER(hash, der []byte, expected common.Address) (SignatureResult, error) {
+	var sig derECDSASignature
+	if _, err := asn1.Unmarshal(der, &sig); err != nil {
+		return SignatureResult{}, fmt.Errorf("parse DER signature: %w", err)
+	}
+
+	r, s := sig.R, sig.S
+	if s.Cmp(secp256k1HalfN) > 0 {
+		s = new(big.Int).Sub(secp256k1N, s)
+	}
+
+	rBytes, sBytes := make([]byte, 32), make([]byte, 32)
+	r.FillBytes(rBytes)
+	s.FillBytes(sBytes)
+
+	for recID := byte(0); recID < 2; recID++ {
+		candidate := make([]byte, 65)
+		copy(candidate[:32], rBytes)
+		copy(candidate[32:64], sBytes)
+		candidate[64] = recID
+
+		pubKey, err := crypto.SigToPub(hash, candidate)
+		if err != nil {
+			continue
+		}
+		if crypto.PubkeyToAddress(*pubKey) == expected {
+			return SignatureResult{
+				R: hexutil.EncodeBig(r),
+				S: hexutil.EncodeBig(s),
+				V: int(recID) + 27,
+			}, nil
+		}
+	}
+
+	return SignatureResult{}, fmt.Errorf("could not recover a matching v for address %s", expected.Hex())
+}
+
+// AWSKMSClient is the minimal AWS KMS surface AWSKMSSigner needs, satisfied
+// by e.g. github.com/aws/aws-sdk-go-v2/service/kms's *kms.Client (its Sign
+// method, adapted to return the raw DER signature bytes), without this
+// package taking a direct dependency on the AWS SDK.
+type AWSKMSClient interface {
+	Sign(ctx context.Context, keyID string, digest []byte) (derSignature []byte, err error)
+}
+
+// AWSKMSSigner signs through an AWS KMS asymmetric ECC_SECG_P256K1 key,
+// so the private key material never leaves KMS. Construct it with the
+// address the key corresponds to (KMS has no notion of Ethereum addresses),
+// which SignHash uses to recover the correct v from the DER signature KMS
+// returns.
+type AWSKMSSigner struct {
+	client  AWSKMSClient
+	keyID   string
+	address common.Address
+}
+
+// NewAWSKMSSigner creates an AWSKMSSigner for keyID, whose public key
+// corresponds to address.
+func NewAWSKMSSigner(client AWSKMSClient, keyID string, address common.Address) *AWSKMSSigner {
+	return &AWSKMSSigner{client: client, keyID: keyID, address: address}
+}
+
+func (s *AWSKMSSigner) Address() common.Address {
+	return s.address
+}
+
+func (s *AWSKMSSigner) SignHash(ctx context.Context, hash []byte) (SignatureResult, error) {
+	der, err := s.client.Sign(ctx, s.keyID, hash)
+	if err != nil {
+		return SignatureResult{}, fmt.Errorf("aws kms sign: %w", err)
+	}
+	return signatureResultFromDER(hash, der, s.address)
+}
+
+// GCPKMSClient is the minimal GCP Cloud KMS surface GCPKMSSigner needs,
+// satisfied by e.g. github.com/googleapis/google-cloud-go/kms's
+// *kms.KeyManagementClient (its AsymmetricSign method, adapted to return
+// the raw DER signature bytes), without this package taking a direct
+// dependency on the GCP SDK.
+type GCPKMSClient interface {
+	AsymmetricSign(ctx context.Context, keyVersionName string, digest []byte) (derSignature []byte, err error)
+}
+
+// GCPKMSSigner signs through a GCP Cloud KMS EC_SIGN_SECP256K1_SHA256 key
+// version, so the private key material never leaves KMS. Construct it with
+// the address the key corresponds to (KMS has no notion of Ethereum
+// addresses), which SignHash uses to recover the correct v from the DER
+// signature KMS returns.
+type GCPKMSSigner struct {
+	client         GCPKMSClient
+	keyVersionName string
+	address        common.Address
+}
+
+// NewGCPKMSSigner creates a GCPKMSSigner for the key version identified by
+// keyVersionName (e.g. "projects/p/locations/l/keyRings/r/cryptoKeys/k/cryptoKeyVersions/1"),
+// whose public key corresponds to address.
+func NewGCPKMSSigner(client GCPKMSClient, keyVersionName string, address common.Address) *GCPKMSSigner {
+	return &GCPKMSSigner{client: client, keyVersionName: keyVersionName, address: address}
+}
+
+func (s *GCPKMSSigner) Address() common.Address {
+	return s.address
+}
+
+func (s *GCPKMSSigner) SignHash(ctx context.Context, hash []byte) (SignatureResult, error) {
+	der, err := s.client.AsymmetricSign(ctx, s.keyVersionName, hash)
+	if err != nil {
+		return SignatureResult{}, fmt.Errorf("gcp kms sign: %w", err)
+	}
+	return signatureResultFromDER(hash, der, s.address)
+}
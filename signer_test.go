@@ -0,0 +1,72 @@
+package hyperliquid
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalSignerAddress(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	signer := NewLocalSigner(privateKey)
+	assert.Equal(t, crypto.PubkeyToAddress(privateKey.PublicKey), signer.Address())
+}
+
+func TestLocalSignerMatchesSignL1Action(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	action := map[string]any{"type": "noop"}
+
+	want, err := SignL1Action(privateKey, action, "", 1, nil, false)
+	require.NoError(t, err)
+
+	got, err := SignL1ActionWithSigner(context.Background(), NewLocalSigner(privateKey), action, "", 1, nil, false)
+	require.NoError(t, err)
+
+	assert.Equal(t, want, got)
+}
+
+func TestRemoteSignerSignHash(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(privateKey.PublicKey)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "l1", req["domain"])
+
+		hash, err := hexutil.Decode(req["hash"])
+		require.NoError(t, err)
+
+		signature, err := crypto.Sign(hash, privateKey)
+		require.NoError(t, err)
+
+		resp := SignatureResult{
+			R: hexutil.Encode(signature[:32]),
+			S: hexutil.Encode(signature[32:64]),
+			V: int(signature[64]) + 27,
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	defer srv.Close()
+
+	signer := NewRemoteSigner(address, srv.URL, "l1")
+	assert.Equal(t, address, signer.Address())
+
+	hash := crypto.Keccak256([]byte("hello"))
+	sig, err := signer.SignHash(context.Background(), hash)
+	require.NoError(t, err)
+	assert.NotEmpty(t, sig.R)
+	assert.NotEmpty(t, sig.S)
+}
@@ -1,7 +1,7 @@
 package hyperliquid
 
 import (
-	"bytes"
+	"context"
 	"crypto/ecdsa"
 	"encoding/binary"
 	"encoding/hex"
@@ -11,11 +11,9 @@ import (
 	"strings"
 	"time"
 
-	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
-	"github.com/vmihailenco/msgpack/v5"
 )
 
 func addressToBytes(address string) []byte {
@@ -24,58 +22,16 @@ func addressToBytes(address string) []byte {
 	return bytes
 }
 
-// convertStr16ToStr8 converts msgpack str16 (0xda + 2 byte length) to str8 (0xd9 + 1 byte length)
-// for strings <256 bytes to match Python msgpack behavior
-func convertStr16ToStr8(data []byte) []byte {
-	result := make([]byte, 0, len(data))
-	i := 0
-
-	for i < len(data) {
-		b := data[i]
-
-		// Check if it's str16 (0xda)
-		if b == 0xda && i+2 < len(data) {
-			// Read 2-byte big-endian length
-			length := (int(data[i+1]) << 8) | int(data[i+2])
-
-			// If length fits in 1 byte, convert to str8 (0xd9)
-			if length < 256 {
-				result = append(result, 0xd9)
-				result = append(result, byte(length))
-				i += 3
-				// Copy the string data
-				if i+length <= len(data) {
-					result = append(result, data[i:i+length]...)
-					i += length
-				}
-				continue
-			}
-		}
-
-		result = append(result, b)
-		i++
-	}
-
-	return result
-}
-
 func actionHash(action any, vaultAddress string, nonce int64, expiresAfter *int64) []byte {
-	var buf bytes.Buffer
-	enc := msgpack.NewEncoder(&buf)
-	// CRITICAL: Do NOT use SetSortMapKeys(true) - Python preserves insertion order
-	// Structs in Go will serialize fields in the order they are defined
-	enc.UseCompactInts(true)
-
-	err := enc.Encode(action)
+	// canonicalMarshal replaces vmihailenco/msgpack/v5 + convertStr16ToStr8:
+	// it preserves struct field order directly via reflection, sorts
+	// map[string]any keys (Go map order is otherwise undefined), and picks
+	// the same str8/str16/str32 and compact-int widths as the Python
+	// reference, so there is no separate post-processing pass anymore.
+	data, err := canonicalMarshal(action)
 	if err != nil {
 		panic(fmt.Sprintf("failed to marshal action: %v", err))
 	}
-	data := buf.Bytes()
-
-	// Convert fixstr to str8 for Python compatibility
-	data = convertStr16ToStr8(data)
-
-	// fmt.Printf("🔍 DEBUG actionHash msgpack: %s\n", hex.EncodeToString(data))
 
 	// Add nonce as 8 bytes big endian
 	if nonce < 0 {
@@ -178,54 +134,49 @@ func hashStructLenient(
 	return typedData.HashStruct(primaryType, filteredMessage)
 }
 
-func signInner(
-	privateKey *ecdsa.PrivateKey,
-	typedData apitypes.TypedData,
-) (SignatureResult, error) {
-	// Create EIP-712 hash
+// eip712Digest computes the EIP-712 digest (`0x19 0x01 || domainSeparator ||
+// hashStruct(message)`) for typedData using lenient hashing (extra fields in
+// message beyond those declared in the primary type are ignored, matching
+// Python eth_account behavior).
+func eip712Digest(typedData apitypes.TypedData) ([]byte, error) {
 	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
 	if err != nil {
-		return SignatureResult{}, fmt.Errorf("failed to hash domain: %w", err)
+		return nil, fmt.Errorf("failed to hash domain: %w", err)
 	}
 
-	// Use lenient hashing to allow extra fields in message (Python compatibility)
 	typedDataHash, err := hashStructLenient(typedData, typedData.PrimaryType, typedData.Message)
 	if err != nil {
-		return SignatureResult{}, fmt.Errorf("failed to hash typed data: %w", err)
+		return nil, fmt.Errorf("failed to hash typed data: %w", err)
 	}
 
 	rawData := []byte{0x19, 0x01}
 	rawData = append(rawData, domainSeparator...)
 	rawData = append(rawData, typedDataHash...)
-	msgHash := crypto.Keccak256Hash(rawData)
+	return crypto.Keccak256(rawData), nil
+}
 
-	signature, err := crypto.Sign(msgHash.Bytes(), privateKey)
+// signInnerWithSigner is the Signer-based core of signInner. Every
+// signature in this package ultimately flows through here.
+func signInnerWithSigner(
+	ctx context.Context,
+	signer Signer,
+	typedData apitypes.TypedData,
+) (SignatureResult, error) {
+	digest, err := eip712Digest(typedData)
 	if err != nil {
-		return SignatureResult{}, fmt.Errorf("failed to sign message: %w", err)
+		return SignatureResult{}, err
 	}
+	return signer.SignHash(ctx, digest)
+}
 
-	// Extract r, s, v components
-	r := new(big.Int).SetBytes(signature[:32])
-	s := new(big.Int).SetBytes(signature[32:64])
-	v := int(signature[64]) + 27
-
-	// DEBUG: Verify signature recovery
-	//pubKey, err := crypto.SigToPub(msgHash.Bytes(), signature)
-	//if err == nil {
-	//	recoveredAddr := crypto.PubkeyToAddress(*pubKey)
-	//	expectedAddr := crypto.PubkeyToAddress(privateKey.PublicKey)
-	//	fmt.Printf("   DEBUG SIGNATURE:\n")
-	//	fmt.Printf("   Expected address: %s\n", expectedAddr.Hex())
-	//	fmt.Printf("   Recovered address: %s\n", recoveredAddr.Hex())
-	//	fmt.Printf("   Match: %v\n", recoveredAddr.Hex() == expectedAddr.Hex())
-	//	fmt.Printf("   msgHash: %s\n", msgHash.Hex())
-	//}
-
-	return SignatureResult{
-		R: hexutil.EncodeBig(r),
-		S: hexutil.EncodeBig(s),
-		V: v,
-	}, nil
+// signInner signs typedData with a raw private key. It is a thin wrapper
+// around signInnerWithSigner kept for backward compatibility; new code
+// should prefer passing a Signer explicitly.
+func signInner(
+	privateKey *ecdsa.PrivateKey,
+	typedData apitypes.TypedData,
+) (SignatureResult, error) {
+	return signInnerWithSigner(context.Background(), NewLocalSigner(privateKey), typedData)
 }
 
 // structToOrderedMap converts a struct to a map preserving JSON tag order
@@ -253,13 +204,18 @@ func structToOrderedMap(v any) (map[string]any, error) {
 // "422 Failed to deserialize the JSON body" and "User or API Wallet 0x123... does not exist".
 // This matches Python SDK behavior where the field order doesn't matter and extra fields (type, signatureChainId)
 // are present in the message but ignored during EIP-712 hashing via hashStructLenient.
-func SignUserSignedAction(
-	privateKey *ecdsa.PrivateKey,
+// BuildUserSignedTypedData builds the fully-populated TypedData for a
+// user-signed action (approveAgent, approveBuilderFee, ...) and its
+// EIP-712 digest, without signing anything. This is what a hardware
+// wallet will render on-screen and what an audit log should capture
+// verbatim before the user approves it. Like SignUserSignedAction, it adds
+// signatureChainId/hyperliquidChain to action in place.
+func BuildUserSignedTypedData(
 	action map[string]any,
 	payloadTypes []apitypes.Type,
 	primaryType string,
 	isMainnet bool,
-) (SignatureResult, error) {
+) (apitypes.TypedData, [32]byte, error) {
 	// Add signatureChainId based on environment
 	// signatureChainId is the chain used by the wallet to sign.
 	// hyperliquidChain determines the environment and prevents replay attacks.
@@ -292,31 +248,128 @@ func SignUserSignedAction(
 		Message:     action,
 	}
 
-	// signInner uses hashStructLenient which filters message to only include
-	// fields declared in payloadTypes, matching Python eth_account behavior
-	return signInner(privateKey, typedData)
+	digestBytes, err := eip712Digest(typedData)
+	if err != nil {
+		return apitypes.TypedData{}, [32]byte{}, err
+	}
+
+	var digest [32]byte
+	copy(digest[:], digestBytes)
+	return typedData, digest, nil
 }
 
-func SignL1Action(
+// EncodeTypedDataJSON marshals td into the exact JSON form an
+// eth_signTypedData_v4 request body expects, suitable for display or for
+// relaying to a hardware wallet / remote signer bridge.
+func EncodeTypedDataJSON(td apitypes.TypedData) ([]byte, error) {
+	data, err := json.Marshal(td)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode typed data: %w", err)
+	}
+	return data, nil
+}
+
+// SignUserSignedActionWithSigner is the Signer-based core of
+// SignUserSignedAction.
+func SignUserSignedActionWithSigner(
+	ctx context.Context,
+	signer Signer,
+	action map[string]any,
+	payloadTypes []apitypes.Type,
+	primaryType string,
+	isMainnet bool,
+) (SignatureResult, error) {
+	// signInnerWithSigner uses hashStructLenient which filters message to
+	// only include fields declared in payloadTypes, matching Python
+	// eth_account behavior
+	typedData, _, err := BuildUserSignedTypedData(action, payloadTypes, primaryType, isMainnet)
+	if err != nil {
+		return SignatureResult{}, err
+	}
+	return signInnerWithSigner(ctx, signer, typedData)
+}
+
+// SignUserSignedAction signs actions that require direct EIP-712 signing
+// using a raw private key. It is a thin wrapper around
+// SignUserSignedActionWithSigner kept for backward compatibility.
+func SignUserSignedAction(
 	privateKey *ecdsa.PrivateKey,
+	action map[string]any,
+	payloadTypes []apitypes.Type,
+	primaryType string,
+	isMainnet bool,
+) (SignatureResult, error) {
+	return SignUserSignedActionWithSigner(
+		context.Background(),
+		NewLocalSigner(privateKey),
+		action,
+		payloadTypes,
+		primaryType,
+		isMainnet,
+	)
+}
+
+// BuildL1TypedData builds the fully-populated TypedData for an L1 action
+// and its EIP-712 digest, without signing anything. This is what a
+// hardware wallet will render on-screen and what an audit log should
+// capture verbatim before the user approves it.
+func BuildL1TypedData(
 	action any,
 	vaultAddress string,
 	timestamp int64,
 	expiresAfter *int64,
 	isMainnet bool,
-) (SignatureResult, error) {
-	// Step 1: Create action hash
+) (apitypes.TypedData, [32]byte, error) {
 	hash := actionHash(action, vaultAddress, timestamp, expiresAfter)
-	//fmt.Printf("[DEBUG] SignL1Action - ActionHash: %x\n", hash)
-
-	// Step 2: Construct phantom agent
 	phantomAgent := constructPhantomAgent(hash, isMainnet)
-
-	// Step 3: Create l1 payload
 	typedData := l1Payload(phantomAgent, isMainnet)
 
-	// Step 4: Sign using EIP-712
-	return signInner(privateKey, typedData)
+	digestBytes, err := eip712Digest(typedData)
+	if err != nil {
+		return apitypes.TypedData{}, [32]byte{}, err
+	}
+
+	var digest [32]byte
+	copy(digest[:], digestBytes)
+	return typedData, digest, nil
+}
+
+// SignL1ActionWithSigner is the Signer-based core of SignL1Action.
+func SignL1ActionWithSigner(
+	ctx context.Context,
+	signer Signer,
+	action any,
+	vaultAddress string,
+	timestamp int64,
+	expiresAfter *int64,
+	isMainnet bool,
+) (SignatureResult, error) {
+	typedData, _, err := BuildL1TypedData(action, vaultAddress, timestamp, expiresAfter, isMainnet)
+	if err != nil {
+		return SignatureResult{}, err
+	}
+	return signInnerWithSigner(ctx, signer, typedData)
+}
+
+// SignL1Action signs an L1 action using a raw private key. It is a thin
+// wrapper around SignL1ActionWithSigner kept for backward compatibility.
+func SignL1Action(
+	privateKey *ecdsa.PrivateKey,
+	action any,
+	vaultAddress string,
+	timestamp int64,
+	expiresAfter *int64,
+	isMainnet bool,
+) (SignatureResult, error) {
+	return SignL1ActionWithSigner(
+		context.Background(),
+		NewLocalSigner(privateKey),
+		action,
+		vaultAddress,
+		timestamp,
+		expiresAfter,
+		isMainnet,
+	)
 }
 
 type signUsdClassTransferAction struct {
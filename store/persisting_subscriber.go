@@ -0,0 +1,54 @@
+package store
+
+import (
+	"context"
+
+	hyperliquid "github.com/sonirico/go-hyperliquid"
+)
+
+// PersistingSubscriber wraps Candle/Trades callbacks so every message is
+// written to a Store before being forwarded to a user callback, matching
+// the WebsocketClient.Candles/Trades callback signatures.
+type PersistingSubscriber struct {
+	store *Store
+	ctx   context.Context
+}
+
+// NewPersistingSubscriber returns a PersistingSubscriber that persists into
+// store using ctx for each write.
+func NewPersistingSubscriber(ctx context.Context, store *Store) *PersistingSubscriber {
+	return &PersistingSubscriber{store: store, ctx: ctx}
+}
+
+// Candles wraps next, persisting every candle before it is forwarded.
+func (p *PersistingSubscriber) Candles(
+	next func(hyperliquid.Candle, error),
+) func(hyperliquid.Candle, error) {
+	return func(c hyperliquid.Candle, err error) {
+		if err == nil {
+			if dbErr := p.store.InsertCandle(p.ctx, c); dbErr != nil {
+				next(c, dbErr)
+				return
+			}
+		}
+		next(c, err)
+	}
+}
+
+// Trades wraps next, persisting every trade in the batch before it is
+// forwarded.
+func (p *PersistingSubscriber) Trades(
+	next func(hyperliquid.Trades, error),
+) func(hyperliquid.Trades, error) {
+	return func(trades hyperliquid.Trades, err error) {
+		if err == nil {
+			for _, t := range trades {
+				if dbErr := p.store.InsertTrade(p.ctx, t); dbErr != nil {
+					next(trades, dbErr)
+					return
+				}
+			}
+		}
+		next(trades, err)
+	}
+}
@@ -0,0 +1,57 @@
+package store
+
+// insertCandleSQL and friends hand-roll per-dialect SQL rather than pulling
+// in a query builder: there are only three statements and the dialects
+// differ solely in placeholder syntax and upsert conflict clause.
+
+func insertCandleSQL(d Dialect) string {
+	if d == DialectPostgres {
+		return `INSERT INTO candles (coin, interval, t_open, t_close, trades, open, high, low, close, volume)
+			VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10)
+			ON CONFLICT (coin, interval, t_open) DO UPDATE SET
+				t_close = EXCLUDED.t_close,
+				trades = EXCLUDED.trades,
+				open = EXCLUDED.open,
+				high = EXCLUDED.high,
+				low = EXCLUDED.low,
+				close = EXCLUDED.close,
+				volume = EXCLUDED.volume`
+	}
+
+	return `INSERT INTO candles (coin, interval, t_open, t_close, trades, open, high, low, close, volume)
+		VALUES (?,?,?,?,?,?,?,?,?,?)
+		ON CONFLICT (coin, interval, t_open) DO UPDATE SET
+			t_close = excluded.t_close,
+			trades = excluded.trades,
+			open = excluded.open,
+			high = excluded.high,
+			low = excluded.low,
+			close = excluded.close,
+			volume = excluded.volume`
+}
+
+func insertTradeSQL(d Dialect) string {
+	if d == DialectPostgres {
+		return `INSERT INTO trades (coin, side, px, sz, time, hash, tid)
+			VALUES ($1,$2,$3,$4,$5,$6,$7)
+			ON CONFLICT (coin, tid) DO NOTHING`
+	}
+
+	return `INSERT INTO trades (coin, side, px, sz, time, hash, tid)
+		VALUES (?,?,?,?,?,?,?)
+		ON CONFLICT (coin, tid) DO NOTHING`
+}
+
+func queryCandlesSQL(d Dialect) string {
+	if d == DialectPostgres {
+		return `SELECT t_open, t_close, trades, open, high, low, close, volume
+			FROM candles
+			WHERE coin = $1 AND interval = $2 AND t_open >= $3 AND t_open <= $4
+			ORDER BY t_open ASC`
+	}
+
+	return `SELECT t_open, t_close, trades, open, high, low, close, volume
+		FROM candles
+		WHERE coin = ? AND interval = ? AND t_open >= ? AND t_open <= ?
+		ORDER BY t_open ASC`
+}
@@ -0,0 +1,105 @@
+// Package store provides optional SQL persistence for streamed Candle and
+// Trade messages, so historical bars accumulated from the WS feed can be
+// reused for backtests without a second REST fetcher. It is driver-agnostic:
+// callers open a *sql.DB with whichever driver they need (e.g. mattn/go-sqlite3
+// or lib/pq, imported blank) and pass it to New.
+package store
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+
+	hyperliquid "github.com/sonirico/go-hyperliquid"
+)
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// Dialect selects the SQL dialect used for schema migration and queries.
+type Dialect string
+
+const (
+	DialectSQLite   Dialect = "sqlite"
+	DialectPostgres Dialect = "postgres"
+)
+
+// Store persists streamed Candle and Trade messages via database/sql.
+type Store struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+// New opens a Store against db, applying the embedded schema migration for
+// dialect if it has not already been applied.
+func New(ctx context.Context, db *sql.DB, dialect Dialect) (*Store, error) {
+	s := &Store{db: db, dialect: dialect}
+
+	if err := s.migrate(ctx); err != nil {
+		return nil, fmt.Errorf("migrate: %w", err)
+	}
+
+	return s, nil
+}
+
+func (s *Store) migrate(ctx context.Context) error {
+	name := fmt.Sprintf("migrations/0001_init.%s.sql", s.dialect)
+
+	schema, err := migrations.ReadFile(name)
+	if err != nil {
+		return fmt.Errorf("read migration %s: %w", name, err)
+	}
+
+	_, err = s.db.ExecContext(ctx, string(schema))
+	return err
+}
+
+// InsertCandle upserts c keyed by (coin, interval, open time).
+func (s *Store) InsertCandle(ctx context.Context, c hyperliquid.Candle) error {
+	_, err := s.db.ExecContext(ctx, insertCandleSQL(s.dialect),
+		c.Symbol, c.Interval, c.TimeOpen, c.TimeClose, c.TradesCount,
+		c.Open, c.High, c.Low, c.Close, c.Volume,
+	)
+	if err != nil {
+		return fmt.Errorf("insert candle: %w", err)
+	}
+	return nil
+}
+
+// InsertTrade inserts t, ignoring duplicates keyed by (coin, tid).
+func (s *Store) InsertTrade(ctx context.Context, t hyperliquid.Trade) error {
+	_, err := s.db.ExecContext(ctx, insertTradeSQL(s.dialect),
+		t.Coin, t.Side, t.Px, t.Sz, t.Time, t.Hash, t.Tid,
+	)
+	if err != nil {
+		return fmt.Errorf("insert trade: %w", err)
+	}
+	return nil
+}
+
+// QueryCandles returns the candles for coin/interval with open time in
+// [from, to], ordered oldest first.
+func (s *Store) QueryCandles(
+	ctx context.Context, coin, interval string, from, to int64,
+) ([]hyperliquid.Candle, error) {
+	rows, err := s.db.QueryContext(ctx, queryCandlesSQL(s.dialect), coin, interval, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("query candles: %w", err)
+	}
+	defer rows.Close()
+
+	var out []hyperliquid.Candle
+	for rows.Next() {
+		c := hyperliquid.Candle{Symbol: coin, Interval: interval}
+		if err := rows.Scan(
+			&c.TimeOpen, &c.TimeClose, &c.TradesCount,
+			&c.Open, &c.High, &c.Low, &c.Close, &c.Volume,
+		); err != nil {
+			return nil, fmt.Errorf("scan candle: %w", err)
+		}
+		out = append(out, c)
+	}
+
+	return out, rows.Err()
+}
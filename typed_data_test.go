@@ -0,0 +1,62 @@
+package hyperliquid
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildL1TypedDataMatchesSignature(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	action := map[string]any{"type": "noop"}
+
+	td, digest, err := BuildL1TypedData(action, "", 1, nil, false)
+	require.NoError(t, err)
+	assert.NotEmpty(t, digest)
+
+	sig1, err := SignL1Action(privateKey, action, "", 1, nil, false)
+	require.NoError(t, err)
+
+	sig2, err := signInnerWithSigner(context.Background(), NewLocalSigner(privateKey), td)
+	require.NoError(t, err)
+	assert.Equal(t, sig1, sig2)
+
+	raw, err := EncodeTypedDataJSON(td)
+	require.NoError(t, err)
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(raw, &decoded))
+	assert.Equal(t, "Agent", decoded["primaryType"])
+}
+
+func TestBuildUserSignedTypedData(t *testing.T) {
+	action := map[string]any{
+		"type":         "approveAgent",
+		"agentAddress": "0x0000000000000000000000000000000000000000",
+		"agentName":    "n",
+		"nonce":        float64(1),
+	}
+	payloadTypes := []apitypes.Type{
+		{Name: "hyperliquidChain", Type: "string"},
+		{Name: "agentAddress", Type: "address"},
+		{Name: "agentName", Type: "string"},
+		{Name: "nonce", Type: "uint64"},
+	}
+
+	td, digest, err := BuildUserSignedTypedData(
+		action,
+		payloadTypes,
+		"HyperliquidTransaction:ApproveAgent",
+		true,
+	)
+	require.NoError(t, err)
+	assert.NotEmpty(t, digest)
+	assert.Equal(t, "Mainnet", action["hyperliquidChain"])
+	assert.Equal(t, "HyperliquidTransaction:ApproveAgent", td.PrimaryType)
+}
@@ -0,0 +1,173 @@
+package hyperliquid
+
+import (
+	"fmt"
+	"sync"
+)
+
+// UserEventStream fans out a single user's orderUpdates, userFills, and
+// webData2 messages into typed handlers, reconciling order status
+// transitions (new/filled/canceled) instead of leaving callers to diff raw
+// snapshots themselves.
+type UserEventStream struct {
+	ws   *WebsocketClient
+	user string
+
+	mu      sync.RWMutex
+	orders  map[int64]WsOrder
+	seen    map[orderEventKey]struct{}
+	chState *ClearinghouseState
+
+	// OnOrderNew fires the first time an order id is observed.
+	OnOrderNew func(WsOrder)
+	// OnOrderFilled fires when an order transitions to a filled status.
+	OnOrderFilled func(WsOrder)
+	// OnOrderCanceled fires when an order transitions to a canceled,
+	// rejected, or otherwise terminal non-filled status.
+	OnOrderCanceled func(WsOrder)
+	// OnLiquidation fires for every userFills entry carrying liquidation
+	// details.
+	OnLiquidation func(WsOrderFill)
+	// OnBalanceUpdate fires with the latest clearinghouse state whenever a
+	// webData2 message is received.
+	OnBalanceUpdate func(ClearinghouseState)
+	// OnPositionUpdate fires once per asset position in the latest
+	// clearinghouse state.
+	OnPositionUpdate func(AssetPosition)
+}
+
+// orderEventKey dedups repeated order notifications: the same (oid, status
+// timestamp) pair can arrive more than once across reconnects and resent
+// snapshots.
+type orderEventKey struct {
+	oid             int64
+	statusTimestamp int64
+}
+
+// UserEventStreamSnapshot is a point-in-time view of the state
+// UserEventStream has reconciled from the stream so far.
+type UserEventStreamSnapshot struct {
+	Orders             []WsOrder
+	ClearinghouseState *ClearinghouseState
+}
+
+// NewUserEventStream subscribes to orderUpdates, userFills, and webData2 for
+// user. Set the On* handlers on the returned stream before events you care
+// about can plausibly arrive.
+func NewUserEventStream(ws *WebsocketClient, user string) (*UserEventStream, error) {
+	s := &UserEventStream{
+		ws:     ws,
+		user:   user,
+		orders: make(map[int64]WsOrder),
+		seen:   make(map[orderEventKey]struct{}),
+	}
+
+	if _, err := ws.OrderUpdates(OrderUpdatesSubscriptionParams{User: user}, s.onOrderUpdates); err != nil {
+		return nil, fmt.Errorf("subscribe orderUpdates: %w", err)
+	}
+
+	if _, err := ws.OrderFills(OrderFillsSubscriptionParams{User: user}, s.onUserFills); err != nil {
+		return nil, fmt.Errorf("subscribe userFills: %w", err)
+	}
+
+	if _, err := ws.WebData2(WebData2SubscriptionParams{User: user}, s.onWebData2); err != nil {
+		return nil, fmt.Errorf("subscribe webData2: %w", err)
+	}
+
+	return s, nil
+}
+
+func (s *UserEventStream) onOrderUpdates(orders WsOrders, err error) {
+	if err != nil {
+		return
+	}
+
+	for _, o := range orders {
+		s.handleOrder(o)
+	}
+}
+
+func (s *UserEventStream) handleOrder(o WsOrder) {
+	key := orderEventKey{oid: o.Order.Oid, statusTimestamp: o.StatusTimestamp}
+
+	s.mu.Lock()
+	if _, dup := s.seen[key]; dup {
+		s.mu.Unlock()
+		return
+	}
+	s.seen[key] = struct{}{}
+
+	_, known := s.orders[o.Order.Oid]
+	s.orders[o.Order.Oid] = o
+	s.mu.Unlock()
+
+	if !known && s.OnOrderNew != nil {
+		s.OnOrderNew(o)
+	}
+
+	switch string(o.Status) {
+	case "filled":
+		if s.OnOrderFilled != nil {
+			s.OnOrderFilled(o)
+		}
+	case "canceled", "marginCanceled", "liquidatedCanceled", "rejected",
+		"openInterestCapCanceled", "selfTradeCanceled", "reduceOnlyCanceled",
+		"siblingFilledCanceled", "delistedCanceled", "scheduledCancel":
+		if s.OnOrderCanceled != nil {
+			s.OnOrderCanceled(o)
+		}
+	}
+}
+
+func (s *UserEventStream) onUserFills(fills []WsOrderFill, err error) {
+	if err != nil {
+		return
+	}
+
+	for _, f := range fills {
+		if f.Liquidation != nil && s.OnLiquidation != nil {
+			s.OnLiquidation(f)
+		}
+	}
+}
+
+func (s *UserEventStream) onWebData2(data WebData2, err error) {
+	if err != nil {
+		return
+	}
+
+	s.mu.Lock()
+	s.chState = data.ClearinghouseState
+	s.mu.Unlock()
+
+	if data.ClearinghouseState == nil {
+		return
+	}
+
+	if s.OnBalanceUpdate != nil {
+		s.OnBalanceUpdate(*data.ClearinghouseState)
+	}
+
+	if s.OnPositionUpdate != nil {
+		for _, pos := range data.ClearinghouseState.AssetPositions {
+			s.OnPositionUpdate(pos)
+		}
+	}
+}
+
+// Snapshot returns the reconciled order book and latest clearinghouse state
+// observed so far.
+func (s *UserEventStream) Snapshot() UserEventStreamSnapshot {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	orders := make([]WsOrder, 0, len(s.orders))
+	for _, o := range s.orders {
+		orders = append(orders, o)
+	}
+
+	return UserEventStreamSnapshot{
+		Orders:             orders,
+		ClearinghouseState: s.chState,
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"math"
 	"strconv"
-	"strings"
 )
 
 // roundToDecimals rounds a float64 to the specified number of decimals.
@@ -13,6 +12,23 @@ func roundToDecimals(value float64, decimals int) float64 {
 	return math.Round(value*pow) / pow
 }
 
+// roundDownToDecimals rounds value toward zero (truncating) to the
+// specified number of decimals.
+func roundDownToDecimals(value float64, decimals int) float64 {
+	pow := math.Pow(10, float64(decimals))
+	return math.Trunc(value*pow) / pow
+}
+
+// roundUpToDecimals rounds value away from zero to the specified number of
+// decimals.
+func roundUpToDecimals(value float64, decimals int) float64 {
+	pow := math.Pow(10, float64(decimals))
+	if value >= 0 {
+		return math.Ceil(value*pow) / pow
+	}
+	return math.Floor(value*pow) / pow
+}
+
 // parseFloat parses a string to float64, returns 0.0 if parsing fails.
 func parseFloat(s string) float64 {
 	f, err := strconv.ParseFloat(s, 64)
@@ -35,70 +51,40 @@ func formatFloat(f float64) string {
 	return fmt.Sprintf("%.6f", f)
 }
 
-// floatToWire converts a float64 to a wire-compatible string format
+// floatToWire converts a float64 to a wire-compatible string format.
+//
+// Deprecated: this goes through float64. The previous implementation
+// additionally rejected any x whose %.8f rendering, parsed back to
+// float64, differed from x by >= 1e-12 — a trap that could reject
+// legitimate prices carrying ordinary float64 representation error (e.g.
+// 0.1+0.2 == 0.30000000000000004). Routing through Decimal drops that
+// check: Decimal already rounds to exactly the 8 decimal places the wire
+// format allows, so there is nothing left to round-trip against. Prefer
+// DecimalFromString(...).Wire() or a Price/Size built from an exact
+// decimal string; this shim is kept only so existing float64 call sites
+// still compile.
 func floatToWire(x float64) (string, error) {
-	// Format to 8 decimal places
-	rounded := fmt.Sprintf("%.8f", x)
-
-	// Check if rounding causes significant error
-	parsed, err := strconv.ParseFloat(rounded, 64)
+	d, err := DecimalFromFloat(x)
 	if err != nil {
 		return "", err
 	}
-
-	if math.Abs(parsed-x) >= 1e-12 {
-		return "", fmt.Errorf("float_to_wire causes rounding: %f", x)
-	}
-
-	// Handle -0 case
-	if rounded == "-0.00000000" {
-		rounded = "0.00000000"
-	}
-
-	// Remove trailing zeros and decimal point if not needed
-	result := strings.TrimRight(rounded, "0")
-	result = strings.TrimRight(result, ".")
-
-	return result, nil
+	return d.Wire(), nil
 }
 
+// roundToSignificantFigures rounds price to sigFigs significant figures.
+//
+// Deprecated: this uses math.Floor/math.Pow, which silently lose
+// precision at BTC-scale notionals. Prefer Decimal.RoundToSignificantFigures;
+// this shim is kept only so existing float64 call sites still compile.
 func roundToSignificantFigures(price float64, sigFigs int) (float64, error) {
 	if price == 0 {
 		return 0, nil
 	}
 
-	// Work with the absolute value of the price to simplify calculations. We will restore the sign later.
-	absPrice := math.Abs(price)
-
-	// Determine the integer part of the absolute price (e.g., for 123.45, integerPart is 123).
-	integerPart := math.Floor(absPrice)
-
-	// Calculate the number of digits in the integer part.
-	// This helps in deciding if we're rounding to an integer or including fractional parts.
-	numIntegerDigits := 0
-	if integerPart > 0 {
-		// Count the number of digits in the integer part.
-		temp := int(integerPart)
-		for temp > 0 {
-			temp = temp / 10
-			numIntegerDigits++
-		}
-	} else {
-		// Since we know the price is not 0 and thus is a fraction, 0 is a significant figure.
-		numIntegerDigits = 1
-	}
-
-	if numIntegerDigits >= sigFigs {
-		// Returning the integer part, keeping the original sign.
-		// We do need to preserve the whole integer part, even though it may result in more significant figures than requested.
-		return math.Copysign(integerPart, price), nil
+	d, err := DecimalFromFloat(price)
+	if err != nil {
+		return 0, err
 	}
 
-	sigFigsLeft := sigFigs - numIntegerDigits
-
-	// Round the float64 to the number of significant figures left.
-	rounded := roundToDecimals(absPrice, sigFigsLeft)
-
-	// Return the rounded number, applying the original sign.
-	return math.Copysign(rounded, price), nil
+	return d.RoundToSignificantFigures(sigFigs).Float64(), nil
 }
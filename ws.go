@@ -11,13 +11,24 @@ import (
 	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/sonirico/go-hyperliquid/cienv"
 )
 
 const (
 	// pingInterval is the interval for sending ping messages to keep WebSocket alive
 	pingInterval = 50 * time.Second
+	// pongWait is how long readPump waits for a pong (or any other frame)
+	// before the read deadline trips and the socket is treated as dead,
+	// catching a half-open connection within roughly one pingInterval
+	// instead of waiting for the OS to notice the peer is gone.
+	pongWait = pingInterval + 20*time.Second
 	// gracefulCloseTimeout is the timeout for graceful WebSocket close
 	gracefulCloseTimeout = 10 * time.Second
+	// defaultReconnectBaseDelay is the first backoff delay reconnect tries.
+	defaultReconnectBaseDelay = 250 * time.Millisecond
+	// defaultReconnectMaxDelay caps the jittered exponential backoff
+	// reconnect uses between dial attempts.
+	defaultReconnectMaxDelay = 30 * time.Second
 )
 
 type WebsocketClient struct {
@@ -28,10 +39,24 @@ type WebsocketClient struct {
 	subscriptions map[subKey]map[int]*subscriptionCallback
 	nextSubID     atomic.Int32
 	done          chan struct{}
-	reconnectWait time.Duration
+
+	reconnectBaseDelay   time.Duration
+	reconnectMaxDelay    time.Duration
+	maxReconnectAttempts int // 0 means unlimited
+	onReconnect          func(attempt int, err error)
+
+	// connState and pong back the ConnState/OnStateChange/WaitReady/LastRTT
+	// surface added alongside reconnect; see ws_connstate.go.
+	connState *connState
+	pong      *pongTracker
+
+	// ciInfo, set via WsOptCIMetadata, tags subscribe/unsubscribe log lines
+	// with the detected CI provider and build URL. Zero value disables
+	// tagging.
+	ciInfo cienv.BuildInfo
 }
 
-func NewWebsocketClient(baseURL string) *WebsocketClient {
+func NewWebsocketClient(baseURL string, opts ...WsOpt) *WebsocketClient {
 	if baseURL == "" {
 		baseURL = MainnetAPIURL
 	}
@@ -43,12 +68,21 @@ func NewWebsocketClient(baseURL string) *WebsocketClient {
 	parsedURL.Path = "/ws"
 	wsURL := parsedURL.String()
 
-	return &WebsocketClient{
-		url:           wsURL,
-		subscriptions: make(map[subKey]map[int]*subscriptionCallback),
-		done:          make(chan struct{}),
-		reconnectWait: time.Second,
+	w := &WebsocketClient{
+		url:                wsURL,
+		subscriptions:      make(map[subKey]map[int]*subscriptionCallback),
+		done:               make(chan struct{}),
+		reconnectBaseDelay: defaultReconnectBaseDelay,
+		reconnectMaxDelay:  defaultReconnectMaxDelay,
+		connState:          newConnState(),
+		pong:               &pongTracker{},
 	}
+
+	for _, opt := range opts {
+		opt.Apply(w)
+	}
+
+	return w
 }
 
 func (w *WebsocketClient) Connect(ctx context.Context) error {
@@ -59,20 +93,35 @@ func (w *WebsocketClient) Connect(ctx context.Context) error {
 		return nil
 	}
 
+	w.connState.set(Connecting, nil)
+
 	dialer := websocket.Dialer{}
 
 	//nolint:bodyclose // WebSocket connections don't have response bodies to close
 	conn, _, err := dialer.DialContext(ctx, w.url, nil)
 	if err != nil {
+		w.connState.set(Disconnected, err)
 		return fmt.Errorf("websocket dial: %w", err)
 	}
 
+	_ = conn.SetReadDeadline(time.Now().Add(pongWait))
+	conn.SetPongHandler(func(string) error {
+		w.pong.recordPong(time.Now())
+		return conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
 	w.conn = conn
 
 	go w.readPump(ctx)
 	go w.pingPump(ctx)
 
-	return w.resubscribeAll()
+	if err := w.resubscribeAll(); err != nil {
+		w.connState.set(Disconnected, err)
+		return err
+	}
+
+	w.connState.set(Connected, nil)
+	return nil
 }
 
 func (w *WebsocketClient) Subscribe(sub Subscription, callback func(WSMessage)) (int, error) {
@@ -131,6 +180,7 @@ func (w *WebsocketClient) Unsubscribe(sub Subscription, id int) error {
 
 func (w *WebsocketClient) Close() error {
 	close(w.done)
+	w.connState.set(Closed, nil)
 
 	w.mu.Lock()
 	defer w.mu.Unlock()
@@ -164,6 +214,7 @@ func (w *WebsocketClient) readPump(ctx context.Context) {
 			if err != nil {
 				if !websocket.IsCloseError(err, websocket.CloseNormalClosure) {
 					log.Printf("websocket read error: %v", err)
+					w.connState.set(Reconnecting, err)
 					w.reconnect()
 				}
 				return
@@ -195,8 +246,10 @@ func (w *WebsocketClient) pingPump(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
+			w.pong.recordPing(time.Now())
 			if err := w.sendPing(); err != nil {
 				log.Printf("ping error: %v", err)
+				w.connState.set(Reconnecting, err)
 				w.reconnect()
 				return
 			}
@@ -217,23 +270,41 @@ func (w *WebsocketClient) dispatch(msg WSMessage) {
 	}
 }
 
+// reconnect redials with decorrelated-jitter backoff (see
+// decorrelatedJitterDelay), re-sending every active subscription's payload
+// via Connect's call to resubscribeAll once a dial succeeds. Subscription
+// callbacks keep firing transparently: resubscribeAll reuses the existing
+// w.subscriptions map rather than asking callers to re-subscribe.
 func (w *WebsocketClient) reconnect() {
+	var prevDelay time.Duration
+	attempt := 0
 	for {
 		select {
 		case <-w.done:
 			return
 		default:
+			attempt++
+			w.connState.set(Reconnecting, nil)
+
 			ctx, cancel := context.WithTimeout(context.Background(), gracefulCloseTimeout)
 			err := w.Connect(ctx)
 			cancel()
+
+			if w.onReconnect != nil {
+				w.onReconnect(attempt, err)
+			}
 			if err == nil {
 				return
 			}
-			time.Sleep(w.reconnectWait)
-			w.reconnectWait *= 2
-			if w.reconnectWait > time.Minute {
-				w.reconnectWait = time.Minute
+
+			if w.maxReconnectAttempts > 0 && attempt >= w.maxReconnectAttempts {
+				log.Printf("websocket reconnect: giving up after %d attempts: %v", attempt, err)
+				w.connState.set(Disconnected, err)
+				return
 			}
+
+			prevDelay = decorrelatedJitterDelay(w.reconnectBaseDelay, w.reconnectMaxDelay, prevDelay)
+			time.Sleep(prevDelay)
 		}
 	}
 }
@@ -256,6 +327,7 @@ func (w *WebsocketClient) resubscribeAll() error {
 }
 
 func (w *WebsocketClient) sendSubscribe(sub Subscription) error {
+	w.logCITag("subscribe", sub)
 	return w.writeJSON(WsCommand{
 		Method:       "subscribe",
 		Subscription: &sub,
@@ -263,12 +335,23 @@ func (w *WebsocketClient) sendSubscribe(sub Subscription) error {
 }
 
 func (w *WebsocketClient) sendUnsubscribe(sub Subscription) error {
+	w.logCITag("unsubscribe", sub)
 	return w.writeJSON(WsCommand{
 		Method:       "unsubscribe",
 		Subscription: &sub,
 	})
 }
 
+// logCITag logs action+sub tagged with the detected CI provider and build
+// URL, when WsOptCIMetadata configured one, so subscriptions made by a CI
+// integration test run can be traced back to the build that made them.
+func (w *WebsocketClient) logCITag(action string, sub Subscription) {
+	if w.ciInfo.Provider == "" {
+		return
+	}
+	log.Printf("websocket %s [ci=%s build=%s]: %s", action, w.ciInfo.Provider, w.ciInfo.BuildURL, sub.Type)
+}
+
 func (w *WebsocketClient) sendPing() error {
 	return w.writeJSON(WsCommand{Method: "ping"})
 }
@@ -281,6 +364,7 @@ func (w *WebsocketClient) writeJSON(v any) error {
 		return fmt.Errorf("connection closed")
 	}
 
+	_ = w.conn.SetWriteDeadline(time.Now().Add(gracefulCloseTimeout))
 	return w.conn.WriteJSON(v)
 }
 
@@ -369,13 +453,13 @@ func (w *WebsocketClient) SubscribeToActiveAssetCtx(
 	return w.Subscribe(sub, callback)
 }
 
+// matchSubscription reports whether msg belongs to the subscription keyed
+// by key. Any channel registered in channelDecoders (see ws_typed.go)
+// matches on key.typ == msg.Channel directly, covering every channel the
+// SDK sends instead of the two this used to hard-code.
 func matchSubscription(key subKey, msg WSMessage) bool {
-	switch key.typ {
-	case "l2Book":
-		return msg.Channel == "l2Book"
-	case "trades":
-		return msg.Channel == "trades"
-	default:
-		return false
+	if _, ok := channelDecoders[msg.Channel]; ok {
+		return key.typ == msg.Channel
 	}
+	return false
 }
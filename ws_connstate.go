@@ -0,0 +1,220 @@
+package hyperliquid
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ConnState is WebsocketClient's user-visible connection lifecycle state.
+type ConnState int
+
+const (
+	// Disconnected means no dial has succeeded yet, or the last one
+	// failed and reconnect hasn't started retrying.
+	Disconnected ConnState = iota
+	// Connecting means a dial is in flight (the initial Connect, or one
+	// attempt inside ConnectWithRetry).
+	Connecting
+	// Connected means the socket is up and resubscription succeeded.
+	Connected
+	// Reconnecting means the socket was lost and reconnect is retrying.
+	Reconnecting
+	// Closed means Close was called; the client will not reconnect.
+	Closed
+)
+
+func (s ConnState) String() string {
+	switch s {
+	case Disconnected:
+		return "disconnected"
+	case Connecting:
+		return "connecting"
+	case Connected:
+		return "connected"
+	case Reconnecting:
+		return "reconnecting"
+	case Closed:
+		return "closed"
+	default:
+		return "unknown"
+	}
+}
+
+// connState holds WebsocketClient's ConnState plus the plumbing
+// OnStateChange/WaitReady need: a callback fired on every transition, and a
+// channel that's open while disconnected and closed exactly while
+// Connected, so WaitReady can select on it instead of polling.
+type connState struct {
+	mu       sync.Mutex
+	state    ConnState
+	onChange func(ConnState, error)
+	readyCh  chan struct{}
+}
+
+func newConnState() *connState {
+	return &connState{state: Disconnected, readyCh: make(chan struct{})}
+}
+
+func (c *connState) set(s ConnState, err error) {
+	c.mu.Lock()
+	prev := c.state
+	c.state = s
+	if s == Connected && prev != Connected {
+		close(c.readyCh)
+	} else if prev == Connected && s != Connected {
+		c.readyCh = make(chan struct{})
+	}
+	cb := c.onChange
+	c.mu.Unlock()
+
+	if cb != nil {
+		cb(s, err)
+	}
+}
+
+func (c *connState) get() ConnState {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.state
+}
+
+func (c *connState) ready() chan struct{} {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.readyCh
+}
+
+// State returns w's current ConnState.
+func (w *WebsocketClient) State() ConnState {
+	return w.connState.get()
+}
+
+// OnStateChange registers fn to be called with every ConnState transition
+// (Connecting, Connected, Reconnecting, Disconnected, Closed) and the error
+// that caused it, if any. Only one handler is kept; a later call replaces
+// an earlier one.
+func (w *WebsocketClient) OnStateChange(fn func(ConnState, error)) {
+	w.connState.mu.Lock()
+	w.connState.onChange = fn
+	w.connState.mu.Unlock()
+}
+
+// WaitReady blocks until w reaches ConnState Connected, ctx is canceled, or
+// the client is closed, whichever comes first.
+func (w *WebsocketClient) WaitReady(ctx context.Context) error {
+	for {
+		if w.State() == Connected {
+			return nil
+		}
+		select {
+		case <-w.connState.ready():
+			if w.State() == Connected {
+				return nil
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-w.done:
+			return fmt.Errorf("websocket client closed")
+		}
+	}
+}
+
+// ConnectRetryPolicy configures WebsocketClient.ConnectWithRetry's initial
+// dial retries. It reuses the same decorrelated-jitter backoff reconnect
+// uses once connected, so a flaky network is handled identically whether
+// the drop happens before or after the first successful dial.
+type ConnectRetryPolicy struct {
+	// MaxAttempts bounds how many dials are tried before giving up. 0
+	// means unlimited (bounded only by ctx).
+	MaxAttempts int
+	// BaseDelay is the first retry's minimum wait. Defaults to
+	// reconnectBaseDelay when zero.
+	BaseDelay time.Duration
+	// MaxDelay caps the backoff. Defaults to reconnectMaxDelay when zero.
+	MaxDelay time.Duration
+}
+
+// ConnectWithRetry dials w the same way Connect does, but retries a failed
+// dial under decorrelated-jitter backoff instead of failing hard on the
+// first error, so a caller starting up against a momentarily-unreachable
+// endpoint doesn't have to hand-roll its own retry loop.
+func (w *WebsocketClient) ConnectWithRetry(ctx context.Context, policy ConnectRetryPolicy) error {
+	base := policy.BaseDelay
+	if base <= 0 {
+		base = w.reconnectBaseDelay
+	}
+	capDelay := policy.MaxDelay
+	if capDelay <= 0 {
+		capDelay = w.reconnectMaxDelay
+	}
+
+	var prev time.Duration
+	for attempt := 1; ; attempt++ {
+		err := w.Connect(ctx)
+		if err == nil {
+			return nil
+		}
+
+		if policy.MaxAttempts > 0 && attempt >= policy.MaxAttempts {
+			return fmt.Errorf("connect: giving up after %d attempt(s): %w", attempt, err)
+		}
+
+		prev = decorrelatedJitterDelay(base, capDelay, prev)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(prev):
+		}
+	}
+}
+
+// decorrelatedJitterDelay implements the decorrelated-jitter backoff
+// (https://aws.amazon.com/blogs/architecture/exponential-backoff-and-jitter/):
+// sleep = min(cap, random_between(base, prev*3)). Unlike full-jitter
+// exponential backoff, this spreads out retries across a window that
+// grows with the previous delay rather than a fixed power of two, which
+// avoids a thundering herd resynchronizing on each doubling.
+func decorrelatedJitterDelay(base, capDelay, prev time.Duration) time.Duration {
+	if prev < base {
+		prev = base
+	}
+	upper := prev * 3
+	if upper > capDelay {
+		upper = capDelay
+	}
+	if upper <= base {
+		return base
+	}
+	return base + time.Duration(rand.Int63n(int64(upper-base)+1))
+}
+
+// pongTracker records ping/pong round-trip time so pingPump can tell a
+// half-open socket (TCP still up, peer gone) from a healthy one: a pong
+// that never arrives within pongWait means the connection is dead even
+// though no read error has fired yet.
+type pongTracker struct {
+	lastPingSent atomic.Int64 // UnixNano
+	lastRTT      atomic.Int64 // nanoseconds
+}
+
+func (p *pongTracker) recordPing(now time.Time) {
+	p.lastPingSent.Store(now.UnixNano())
+}
+
+func (p *pongTracker) recordPong(now time.Time) {
+	sent := p.lastPingSent.Load()
+	if sent == 0 {
+		return
+	}
+	p.lastRTT.Store(int64(now.Sub(time.Unix(0, sent))))
+}
+
+// LastRTT returns the most recently observed ping/pong round-trip time, or
+// 0 if no pong has been observed yet.
+func (w *WebsocketClient) LastRTT() time.Duration {
+	return time.Duration(w.pong.lastRTT.Load())
+}
@@ -0,0 +1,29 @@
+package hyperliquid
+
+import "fmt"
+
+type CandleSubscriptionParams struct {
+	Coin     string
+	Interval string
+}
+
+func (w *WebsocketClient) Candles(
+	params CandleSubscriptionParams,
+	callback func(Candle, error),
+) (*Subscription, error) {
+	payload := remoteCandleSubscriptionPayload{
+		Type:     ChannelCandle,
+		Coin:     params.Coin,
+		Interval: params.Interval,
+	}
+
+	return w.subscribe(payload, func(msg any) {
+		candle, ok := msg.(Candle)
+		if !ok {
+			callback(Candle{}, fmt.Errorf("invalid message type"))
+			return
+		}
+
+		callback(candle, nil)
+	})
+}
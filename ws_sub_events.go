@@ -0,0 +1,206 @@
+package hyperliquid
+
+import "context"
+
+// StreamEvent carries one message delivered by a channel-based subscription
+// (SubscribeL2Book and its siblings below): either a successfully decoded
+// Value, or Err set to whatever error the equivalent callback-based method
+// (L2Book, Trades, ...) would have passed its callback. Exactly one of the
+// two is meaningful per StreamEvent, mirroring the (value, error) pair the
+// callback form already uses.
+type StreamEvent[T any] struct {
+	Value T
+	Err   error
+}
+
+// subscribeConfig is what SubscribeOption mutates; see
+// defaultSubscribeConfig for its defaults.
+type subscribeConfig struct {
+	bufferSize int
+	dropPolicy DropPolicy
+}
+
+func defaultSubscribeConfig() subscribeConfig {
+	return subscribeConfig{
+		bufferSize: defaultTypedBufferSize,
+		dropPolicy: DropOldest,
+	}
+}
+
+// SubscribeOption configures a channel-based subscription's bounded buffer.
+type SubscribeOption func(*subscribeConfig)
+
+// WithSubscribeBufferSize overrides a channel-based subscription's buffer
+// capacity, which otherwise defaults to the same 64 slots
+// TypedSubscribeOpts.BufferSize defaults to.
+func WithSubscribeBufferSize(n int) SubscribeOption {
+	return func(c *subscribeConfig) {
+		c.bufferSize = n
+	}
+}
+
+// WithSubscribeDropPolicy overrides what a channel-based subscription does
+// once its buffer is full and the consumer hasn't kept up. Defaults to
+// DropOldest.
+func WithSubscribeDropPolicy(p DropPolicy) SubscribeOption {
+	return func(c *subscribeConfig) {
+		c.dropPolicy = p
+	}
+}
+
+// subscribeEvents adapts a callback-based subscription method matching the
+// (params, func(T, error)) (*Subscription, error) shape every method below
+// wraps into a channel of StreamEvent[T]. The returned channel closes, and
+// the subscription unsubscribes, when either ctx is done or the returned
+// *Subscription's Unsubscribe is called directly.
+func subscribeEvents[P, T any](
+	ctx context.Context,
+	subscribe func(P, func(T, error)) (*Subscription, error),
+	params P,
+	opts ...SubscribeOption,
+) (<-chan StreamEvent[T], *Subscription, error) {
+	cfg := defaultSubscribeConfig()
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	tc := newTypedChan[StreamEvent[T]](TypedSubscribeOpts{
+		BufferSize: cfg.bufferSize,
+		DropPolicy: cfg.dropPolicy,
+	})
+
+	sub, err := subscribe(params, func(v T, err error) {
+		tc.send(StreamEvent[T]{Value: v, Err: err})
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	go func() {
+		<-ctx.Done()
+		tc.close()
+		_ = sub.Unsubscribe()
+	}()
+
+	return tc.ch, sub, nil
+}
+
+// SubscribeL2Book is L2Book's channel-based sibling: instead of a
+// callback, it returns a channel of StreamEvent[L2Book] a caller can
+// select over alongside other subscriptions.
+func (w *WebsocketClient) SubscribeL2Book(
+	ctx context.Context,
+	params L2BookSubscriptionParams,
+	opts ...SubscribeOption,
+) (<-chan StreamEvent[L2Book], *Subscription, error) {
+	return subscribeEvents(ctx, w.L2Book, params, opts...)
+}
+
+// SubscribeTrades is Trades' channel-based sibling.
+func (w *WebsocketClient) SubscribeTrades(
+	ctx context.Context,
+	params TradesSubscriptionParams,
+	opts ...SubscribeOption,
+) (<-chan StreamEvent[Trades], *Subscription, error) {
+	return subscribeEvents(ctx, w.Trades, params, opts...)
+}
+
+// SubscribeOrderUpdates is OrderUpdates' channel-based sibling.
+func (w *WebsocketClient) SubscribeOrderUpdates(
+	ctx context.Context,
+	params OrderUpdatesSubscriptionParams,
+	opts ...SubscribeOption,
+) (<-chan StreamEvent[WsOrders], *Subscription, error) {
+	return subscribeEvents(ctx, w.OrderUpdates, params, opts...)
+}
+
+// SubscribeTwapStates is TwapStates' channel-based sibling.
+func (w *WebsocketClient) SubscribeTwapStates(
+	ctx context.Context,
+	params TwapStatesSubscriptionParams,
+	opts ...SubscribeOption,
+) (<-chan StreamEvent[TwapStates], *Subscription, error) {
+	return subscribeEvents(ctx, w.TwapStates, params, opts...)
+}
+
+// SubscribeTwapFills is TwapFills' channel-based sibling.
+func (w *WebsocketClient) SubscribeTwapFills(
+	ctx context.Context,
+	params TwapFillsSubscriptionParams,
+	opts ...SubscribeOption,
+) (<-chan StreamEvent[[]TwapSliceFill], *Subscription, error) {
+	return subscribeEvents(ctx, w.TwapFills, params, opts...)
+}
+
+// SubscribeBbo is Bbo's channel-based sibling.
+func (w *WebsocketClient) SubscribeBbo(
+	ctx context.Context,
+	params BboSubscriptionParams,
+	opts ...SubscribeOption,
+) (<-chan StreamEvent[Bbo], *Subscription, error) {
+	return subscribeEvents(ctx, w.Bbo, params, opts...)
+}
+
+// SubscribeActiveAssetCtx is ActiveAssetCtx's channel-based sibling.
+func (w *WebsocketClient) SubscribeActiveAssetCtx(
+	ctx context.Context,
+	params ActiveAssetCtxSubscriptionParams,
+	opts ...SubscribeOption,
+) (<-chan StreamEvent[ActiveAssetCtx], *Subscription, error) {
+	return subscribeEvents(ctx, w.ActiveAssetCtx, params, opts...)
+}
+
+// SubscribeCandles is Candles' channel-based sibling.
+func (w *WebsocketClient) SubscribeCandles(
+	ctx context.Context,
+	params CandleSubscriptionParams,
+	opts ...SubscribeOption,
+) (<-chan StreamEvent[Candle], *Subscription, error) {
+	return subscribeEvents(ctx, w.Candles, params, opts...)
+}
+
+// SubscribeClearinghouseState is ClearinghouseState's channel-based
+// sibling.
+func (w *WebsocketClient) SubscribeClearinghouseState(
+	ctx context.Context,
+	params ClearinghouseStateSubscriptionParams,
+	opts ...SubscribeOption,
+) (<-chan StreamEvent[ClearinghouseState], *Subscription, error) {
+	return subscribeEvents(ctx, w.ClearinghouseState, params, opts...)
+}
+
+// SubscribeOpenOrders is OpenOrders' channel-based sibling.
+func (w *WebsocketClient) SubscribeOpenOrders(
+	ctx context.Context,
+	params OpenOrdersSubscriptionParams,
+	opts ...SubscribeOption,
+) (<-chan StreamEvent[OpenOrders], *Subscription, error) {
+	return subscribeEvents(ctx, w.OpenOrders, params, opts...)
+}
+
+// SubscribeOrderFills is OrderFills' channel-based sibling.
+func (w *WebsocketClient) SubscribeOrderFills(
+	ctx context.Context,
+	params OrderFillsSubscriptionParams,
+	opts ...SubscribeOption,
+) (<-chan StreamEvent[[]WsOrderFill], *Subscription, error) {
+	return subscribeEvents(ctx, w.OrderFills, params, opts...)
+}
+
+// SubscribeWebData2 is WebData2's channel-based sibling.
+func (w *WebsocketClient) SubscribeWebData2(
+	ctx context.Context,
+	params WebData2SubscriptionParams,
+	opts ...SubscribeOption,
+) (<-chan StreamEvent[WebData2], *Subscription, error) {
+	return subscribeEvents(ctx, w.WebData2, params, opts...)
+}
+
+// SubscribeWebData3 is WebData3's channel-based sibling.
+func (w *WebsocketClient) SubscribeWebData3(
+	ctx context.Context,
+	params WebData3SubscriptionParams,
+	opts ...SubscribeOption,
+) (<-chan StreamEvent[WebData3], *Subscription, error) {
+	return subscribeEvents(ctx, w.WebData3, params, opts...)
+}
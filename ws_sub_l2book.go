@@ -0,0 +1,27 @@
+package hyperliquid
+
+import "fmt"
+
+type L2BookSubscriptionParams struct {
+	Coin string
+}
+
+func (w *WebsocketClient) L2Book(
+	params L2BookSubscriptionParams,
+	callback func(L2Book, error),
+) (*Subscription, error) {
+	payload := remoteL2BookSubscriptionPayload{
+		Type: ChannelL2Book,
+		Coin: params.Coin,
+	}
+
+	return w.subscribe(payload, func(msg any) {
+		book, ok := msg.(L2Book)
+		if !ok {
+			callback(L2Book{}, fmt.Errorf("invalid message type"))
+			return
+		}
+
+		callback(book, nil)
+	})
+}
@@ -0,0 +1,27 @@
+package hyperliquid
+
+import "fmt"
+
+type TradesSubscriptionParams struct {
+	Coin string
+}
+
+func (w *WebsocketClient) Trades(
+	params TradesSubscriptionParams,
+	callback func(Trades, error),
+) (*Subscription, error) {
+	payload := remoteTradesSubscriptionPayload{
+		Type: ChannelTrades,
+		Coin: params.Coin,
+	}
+
+	return w.subscribe(payload, func(msg any) {
+		trades, ok := msg.(Trades)
+		if !ok {
+			callback(nil, fmt.Errorf("invalid message type"))
+			return
+		}
+
+		callback(trades, nil)
+	})
+}
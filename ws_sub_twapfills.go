@@ -0,0 +1,37 @@
+package hyperliquid
+
+import "fmt"
+
+// TwapFillsSubscriptionParams selects whose TWAP slice fills to stream.
+type TwapFillsSubscriptionParams struct {
+	User string
+}
+
+// WsTwapFills is the raw message TwapFills delivers: every pending slice
+// fill for User since the last snapshot/update, the same envelope shape
+// WsOrderFills uses for its sibling subscription.
+type WsTwapFills struct {
+	IsSnapshot bool            `json:"isSnapshot,omitempty"`
+	User       string          `json:"user,omitempty"`
+	TwapFills  []TwapSliceFill `json:"twapFills"`
+}
+
+func (w *WebsocketClient) TwapFills(
+	params TwapFillsSubscriptionParams,
+	callback func([]TwapSliceFill, error),
+) (*Subscription, error) {
+	payload := remoteTwapFillsSubscriptionPayload{
+		Type: ChannelTwapFills,
+		User: params.User,
+	}
+
+	return w.subscribe(payload, func(msg any) {
+		fills, ok := msg.(WsTwapFills)
+		if !ok {
+			callback(nil, fmt.Errorf("invalid message type"))
+			return
+		}
+
+		callback(fills.TwapFills, nil)
+	})
+}
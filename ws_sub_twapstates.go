@@ -7,6 +7,44 @@ type TwapStatesSubscriptionParams struct {
 	Dex  *string
 }
 
+// TwapOrder identifies a TWAP placed via Exchange.PlaceTwap, by the same
+// (asset, id) pair Exchange.ModifyTwap/CancelTwap take.
+type TwapOrder struct {
+	Asset  int
+	TwapID int64
+}
+
+// TwapStatus is one TWAP's execution progress and lifecycle state, as
+// returned by Info.InfoTwapHistory.
+type TwapStatus struct {
+	Coin             string `json:"coin"`
+	Side             string `json:"side"`
+	Size             string `json:"sz"`
+	ExecutedSize     string `json:"executedSz"`
+	ExecutedNotional string `json:"executedNtl"`
+	MinutesTotal     int    `json:"minutes"`
+	ReduceOnly       bool   `json:"reduceOnly"`
+	Randomize        bool   `json:"randomize"`
+	Timestamp        int64  `json:"timestamp"`
+	Status           string `json:"status"`
+}
+
+// TwapHistoryEntry pairs a TwapStatus with its twap id, the shape
+// Info.InfoTwapHistory returns one per historical TWAP.
+type TwapHistoryEntry struct {
+	TwapID int64      `json:"twapId"`
+	State  TwapStatus `json:"state"`
+}
+
+// TwapSliceFill is one fill generated by a single TWAP slice, carrying
+// the parent TWAP's id alongside the regular fill fields so a caller can
+// attribute slices back to the TWAP that produced them. Streamed by
+// WebsocketClient.TwapFills.
+type TwapSliceFill struct {
+	Fill   WsOrderFill `json:"fill"`
+	TwapID int64       `json:"twapId"`
+}
+
 func (w *WebsocketClient) TwapStates(
 	params TwapStatesSubscriptionParams,
 	callback func(TwapStates, error),
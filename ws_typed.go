@@ -0,0 +1,488 @@
+package hyperliquid
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// DropPolicy controls what a typed subscription channel does once its
+// bounded buffer is full and the consumer hasn't kept up, so a slow
+// reader can never block WebsocketClient's single read pump.
+type DropPolicy int
+
+const (
+	// DropOldest discards the oldest buffered value to make room for the
+	// newest one, so the channel always reflects the most recent state.
+	// The default.
+	DropOldest DropPolicy = iota
+	// DropNewest discards the incoming value, keeping whatever is already
+	// buffered untouched.
+	DropNewest
+	// Block makes the read pump wait for the consumer to make room. Use
+	// only when the consumer is guaranteed to keep up, since it can stall
+	// delivery to every other subscription sharing the connection.
+	Block
+	// CloseOnOverflow closes the channel and tears down the subscription
+	// the first time the buffer overflows, turning a slow consumer into a
+	// visible failure instead of a silent drop.
+	CloseOnOverflow
+)
+
+// defaultTypedBufferSize is TypedSubscribeOpts.BufferSize's default.
+const defaultTypedBufferSize = 64
+
+// TypedSubscribeOpts configures a typed subscription's bounded channel.
+type TypedSubscribeOpts struct {
+	// BufferSize is the channel's capacity. Defaults to 64 when <= 0.
+	BufferSize int
+	// DropPolicy governs what happens once the buffer is full. Defaults
+	// to DropOldest.
+	DropPolicy DropPolicy
+	// OnSequenceGap, if set, is called whenever a sequenced subscription
+	// (l2Book, trades, candle) detects that the next message doesn't
+	// follow the last one seen for its (coin, type), so the caller can
+	// trigger a REST resync. Ignored by non-sequenced subscriptions.
+	OnSequenceGap func(SequenceGap)
+}
+
+func (o TypedSubscribeOpts) bufferSize() int {
+	if o.BufferSize <= 0 {
+		return defaultTypedBufferSize
+	}
+	return o.BufferSize
+}
+
+// SequenceGap is reported via TypedSubscribeOpts.OnSequenceGap when a
+// sequenced channel's next message doesn't pick up where the last one left
+// off for its (Coin, Interval) pair.
+type SequenceGap struct {
+	Channel  string
+	Coin     string
+	Interval string
+	LastSeq  int64
+	NextSeq  int64
+}
+
+// typedChan fans decoded values of type T into a bounded, DropPolicy-aware
+// channel. It is the backpressure boundary between WebsocketClient's single
+// read pump and one typed subscription's consumer.
+type typedChan[T any] struct {
+	mu     sync.Mutex
+	ch     chan T
+	policy DropPolicy
+	closed bool
+	// done is closed by close() so a send blocked on the Block policy can
+	// abandon its write instead of holding mu (or the channel) forever.
+	done    chan struct{}
+	sending sync.WaitGroup
+}
+
+func newTypedChan[T any](opts TypedSubscribeOpts) *typedChan[T] {
+	return &typedChan[T]{
+		ch:     make(chan T, opts.bufferSize()),
+		policy: opts.DropPolicy,
+		done:   make(chan struct{}),
+	}
+}
+
+func (t *typedChan[T]) send(v T) {
+	t.mu.Lock()
+	if t.closed {
+		t.mu.Unlock()
+		return
+	}
+
+	if t.policy == Block {
+		// Track this send with sending so close() can wait for it to
+		// either land or abandon via done before closing ch, then drop
+		// mu before the blocking write itself: holding mu here would let
+		// a stalled consumer wedge close() (and whatever goroutine calls
+		// it, e.g. a context.Done cleanup) forever, since close() also
+		// needs mu.
+		t.sending.Add(1)
+		t.mu.Unlock()
+		defer t.sending.Done()
+		select {
+		case t.ch <- v:
+		case <-t.done:
+		}
+		return
+	}
+	defer t.mu.Unlock()
+
+	switch t.policy {
+	case DropNewest:
+		select {
+		case t.ch <- v:
+		default:
+		}
+	case CloseOnOverflow:
+		select {
+		case t.ch <- v:
+		default:
+			t.closed = true
+			close(t.ch)
+		}
+	default: // DropOldest
+		for {
+			select {
+			case t.ch <- v:
+				return
+			default:
+				select {
+				case <-t.ch:
+				default:
+				}
+			}
+		}
+	}
+}
+
+func (t *typedChan[T]) close() {
+	t.mu.Lock()
+	if t.closed {
+		t.mu.Unlock()
+		return
+	}
+	t.closed = true
+	close(t.done)
+	t.mu.Unlock()
+
+	// Wait for any Block-policy send in flight to land or abandon via
+	// done before closing ch, since closing ch out from under a pending
+	// send would panic.
+	t.sending.Wait()
+	close(t.ch)
+}
+
+// sequenceTracker remembers the last sequence value seen per key so typed
+// subscriptions to sequenced channels (l2Book, trades, candle) can detect a
+// gap instead of silently continuing on top of missing data.
+type sequenceTracker struct {
+	mu   sync.Mutex
+	last map[string]int64
+}
+
+func newSequenceTracker() *sequenceTracker {
+	return &sequenceTracker{last: make(map[string]int64)}
+}
+
+// check records seq for key and reports the previously seen sequence and
+// whether seq represents a gap. There's no first-class sequence number on
+// these wire messages, so "gap" means seq went backwards or didn't advance,
+// which is the gap signal a REST resync actually needs to act on.
+func (t *sequenceTracker) check(key string, seq int64) (lastSeq int64, hadPrior, gap bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	last, ok := t.last[key]
+	t.last[key] = seq
+	if !ok {
+		return 0, false, false
+	}
+	return last, true, seq <= last
+}
+
+// channelDecoder turns one WSMessage's Data into a typed value, reporting
+// ok=false for messages this decoder doesn't recognize (e.g. a
+// subscriptionResponse echoed on the same channel).
+type channelDecoder func(data json.RawMessage) (any, error)
+
+// channelDecoders registers how to decode every channel WebsocketClient can
+// receive, replacing matchSubscription's hard-coded l2Book/trades-only
+// switch with a lookup any channel can be added to.
+var channelDecoders = map[string]channelDecoder{
+	ChannelAllMids: func(data json.RawMessage) (any, error) {
+		var v AllMids
+		err := json.Unmarshal(data, &v)
+		return v, err
+	},
+	ChannelUserFills: func(data json.RawMessage) (any, error) {
+		var v WsOrderFills
+		err := json.Unmarshal(data, &v)
+		return v, err
+	},
+	ChannelCandle: func(data json.RawMessage) (any, error) {
+		var v Candle
+		err := json.Unmarshal(data, &v)
+		return v, err
+	},
+	ChannelOrderUpdates: func(data json.RawMessage) (any, error) {
+		var v []WsOrder
+		err := json.Unmarshal(data, &v)
+		return WsOrders(v), err
+	},
+	ChannelWebData2: func(data json.RawMessage) (any, error) {
+		var v WebData2
+		err := json.Unmarshal(data, &v)
+		return v, err
+	},
+	ChannelBbo: func(data json.RawMessage) (any, error) {
+		var v Bbo
+		err := json.Unmarshal(data, &v)
+		return v, err
+	},
+	ChannelActiveAssetCtx: func(data json.RawMessage) (any, error) {
+		var v ActiveAssetCtx
+		err := json.Unmarshal(data, &v)
+		return v, err
+	},
+	ChannelL2Book: func(data json.RawMessage) (any, error) {
+		var v L2Book
+		err := json.Unmarshal(data, &v)
+		return v, err
+	},
+	ChannelTrades: func(data json.RawMessage) (any, error) {
+		var v []Trade
+		err := json.Unmarshal(data, &v)
+		return Trades(v), err
+	},
+	channelUserEvents: func(data json.RawMessage) (any, error) {
+		var v WsOrderFills
+		err := json.Unmarshal(data, &v)
+		return v, err
+	},
+	channelUserFundings: func(data json.RawMessage) (any, error) {
+		var v json.RawMessage
+		err := json.Unmarshal(data, &v)
+		return v, err
+	},
+	channelUserNonFundingLedgerUpdates: func(data json.RawMessage) (any, error) {
+		var v json.RawMessage
+		err := json.Unmarshal(data, &v)
+		return v, err
+	},
+}
+
+// channelUserEvents, channelUserFundings and channelUserNonFundingLedgerUpdates
+// name the remaining channels WebsocketClient sends that ws_types.go doesn't
+// already have a ChannelXxx constant for.
+const (
+	channelUserEvents                  = "userEvents"
+	channelUserFundings                = "userFundings"
+	channelUserNonFundingLedgerUpdates = "userNonFundingLedgerUpdates"
+)
+
+// decodeChannel looks up msg.Channel in channelDecoders and decodes
+// msg.Data, reporting ok=false for a channel with no registered decoder
+// (e.g. ChannelSubResponse, ChannelPong) rather than an error.
+func decodeChannel(msg WSMessage) (any, bool, error) {
+	decode, ok := channelDecoders[msg.Channel]
+	if !ok {
+		return nil, false, nil
+	}
+	v, err := decode(msg.Data)
+	if err != nil {
+		return nil, true, fmt.Errorf("decode %s message: %w", msg.Channel, err)
+	}
+	return v, true, nil
+}
+
+// subscribeTyped wires sub up through WebsocketClient.Subscribe, decodes
+// each delivered WSMessage with decode, and fans successfully decoded
+// values into a bounded channel governed by opts.DropPolicy. The returned
+// cancel func closes the channel and unsubscribes sub.
+func subscribeTyped[T any](
+	w *WebsocketClient,
+	sub Subscription,
+	decode func(WSMessage) (T, bool),
+	opts TypedSubscribeOpts,
+) (<-chan T, func(), error) {
+	tc := newTypedChan[T](opts)
+
+	id, err := w.Subscribe(sub, func(msg WSMessage) {
+		v, ok := decode(msg)
+		if !ok {
+			return
+		}
+		tc.send(v)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cancel := func() {
+		tc.close()
+		_ = w.Unsubscribe(sub, id)
+	}
+
+	return tc.ch, cancel, nil
+}
+
+var wsSequenceTracker = newSequenceTracker()
+
+// reportSequenceGap checks seq against the last one seen for key and, if
+// opts.OnSequenceGap is set and a gap is detected, calls it with a
+// SequenceGap describing the jump.
+func reportSequenceGap(opts TypedSubscribeOpts, channel, coin, interval string, seq int64) {
+	key := channel + ":" + coin + ":" + interval
+	last, hadPrior, gap := wsSequenceTracker.check(key, seq)
+	if hadPrior && gap && opts.OnSequenceGap != nil {
+		opts.OnSequenceGap(SequenceGap{
+			Channel:  channel,
+			Coin:     coin,
+			Interval: interval,
+			LastSeq:  last,
+			NextSeq:  seq,
+		})
+	}
+}
+
+// SubscribeL2BookChan subscribes to coin's order book, delivering each
+// snapshot on the returned channel per opts.DropPolicy, and reports a
+// SequenceGap via opts.OnSequenceGap whenever a book's Time doesn't advance
+// past the previous one for coin.
+func (w *WebsocketClient) SubscribeL2BookChan(coin string, opts TypedSubscribeOpts) (<-chan L2Book, func(), error) {
+	sub := Subscription{Type: ChannelL2Book, Coin: coin}
+	return subscribeTyped[L2Book](w, sub, func(msg WSMessage) (L2Book, bool) {
+		v, ok, err := decodeChannel(msg)
+		if err != nil || !ok {
+			return L2Book{}, false
+		}
+		book, ok := v.(L2Book)
+		if !ok {
+			return L2Book{}, false
+		}
+		reportSequenceGap(opts, ChannelL2Book, coin, "", book.Time)
+		return book, true
+	}, opts)
+}
+
+// SubscribeTradesChan subscribes to coin's trade prints, delivering each
+// trade individually on the returned channel, and reports a SequenceGap via
+// opts.OnSequenceGap whenever a trade's Tid doesn't advance past the
+// previous one for coin.
+func (w *WebsocketClient) SubscribeTradesChan(coin string, opts TypedSubscribeOpts) (<-chan Trade, func(), error) {
+	sub := Subscription{Type: ChannelTrades, Coin: coin}
+	tc := newTypedChan[Trade](opts)
+
+	id, err := w.Subscribe(sub, func(msg WSMessage) {
+		v, ok, err := decodeChannel(msg)
+		if err != nil || !ok {
+			return
+		}
+		trades, ok := v.(Trades)
+		if !ok {
+			return
+		}
+		for _, trade := range trades {
+			reportSequenceGap(opts, ChannelTrades, coin, "", trade.Tid)
+			tc.send(trade)
+		}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cancel := func() {
+		tc.close()
+		_ = w.Unsubscribe(sub, id)
+	}
+	return tc.ch, cancel, nil
+}
+
+// SubscribeCandleChan subscribes to coin's interval candles, and reports a
+// SequenceGap via opts.OnSequenceGap whenever a candle's open time doesn't
+// pick up where the previous candle's close time left off.
+func (w *WebsocketClient) SubscribeCandleChan(coin, interval string, opts TypedSubscribeOpts) (<-chan Candle, func(), error) {
+	sub := Subscription{Type: ChannelCandle, Coin: coin, Interval: interval}
+	return subscribeTyped[Candle](w, sub, func(msg WSMessage) (Candle, bool) {
+		v, ok, err := decodeChannel(msg)
+		if err != nil || !ok {
+			return Candle{}, false
+		}
+		candle, ok := v.(Candle)
+		if !ok {
+			return Candle{}, false
+		}
+		reportSequenceGap(opts, ChannelCandle, coin, interval, candle.TimeOpen)
+		return candle, true
+	}, opts)
+}
+
+// SubscribeOrderUpdatesChan subscribes to the authenticated user's order
+// updates, delivering each WsOrder individually on the returned channel.
+func (w *WebsocketClient) SubscribeOrderUpdatesChan(opts TypedSubscribeOpts) (<-chan WsOrder, func(), error) {
+	sub := Subscription{Type: ChannelOrderUpdates}
+	tc := newTypedChan[WsOrder](opts)
+
+	id, err := w.Subscribe(sub, func(msg WSMessage) {
+		v, ok, err := decodeChannel(msg)
+		if err != nil || !ok {
+			return
+		}
+		orders, ok := v.(WsOrders)
+		if !ok {
+			return
+		}
+		for _, order := range orders {
+			tc.send(order)
+		}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cancel := func() {
+		tc.close()
+		_ = w.Unsubscribe(sub, id)
+	}
+	return tc.ch, cancel, nil
+}
+
+// SubscribeUserFillsChan subscribes to user's fills, delivering each
+// WsOrderFill individually on the returned channel.
+func (w *WebsocketClient) SubscribeUserFillsChan(user string, opts TypedSubscribeOpts) (<-chan WsOrderFill, func(), error) {
+	sub := Subscription{Type: ChannelUserFills, User: user}
+	tc := newTypedChan[WsOrderFill](opts)
+
+	id, err := w.Subscribe(sub, func(msg WSMessage) {
+		v, ok, err := decodeChannel(msg)
+		if err != nil || !ok {
+			return
+		}
+		fills, ok := v.(WsOrderFills)
+		if !ok {
+			return
+		}
+		for _, fill := range fills.Fills {
+			tc.send(fill)
+		}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cancel := func() {
+		tc.close()
+		_ = w.Unsubscribe(sub, id)
+	}
+	return tc.ch, cancel, nil
+}
+
+// SubscribeBboChan subscribes to coin's best bid/offer.
+func (w *WebsocketClient) SubscribeBboChan(coin string, opts TypedSubscribeOpts) (<-chan Bbo, func(), error) {
+	sub := Subscription{Type: ChannelBbo, Coin: coin}
+	return subscribeTyped[Bbo](w, sub, func(msg WSMessage) (Bbo, bool) {
+		v, ok, err := decodeChannel(msg)
+		if err != nil || !ok {
+			return Bbo{}, false
+		}
+		bbo, ok := v.(Bbo)
+		return bbo, ok
+	}, opts)
+}
+
+// SubscribeActiveAssetCtxChan subscribes to coin's active asset context.
+func (w *WebsocketClient) SubscribeActiveAssetCtxChan(coin string, opts TypedSubscribeOpts) (<-chan ActiveAssetCtx, func(), error) {
+	sub := Subscription{Type: ChannelActiveAssetCtx, Coin: coin}
+	return subscribeTyped[ActiveAssetCtx](w, sub, func(msg WSMessage) (ActiveAssetCtx, bool) {
+		v, ok, err := decodeChannel(msg)
+		if err != nil || !ok {
+			return ActiveAssetCtx{}, false
+		}
+		ctx, ok := v.(ActiveAssetCtx)
+		return ctx, ok
+	}, opts)
+}
@@ -0,0 +1,64 @@
+package hyperliquid
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTypedChanCloseDoesNotDeadlockOnStalledBlockSend guards against a
+// Block-policy send that's stuck waiting for a reader wedging close()
+// (and whatever goroutine calls it, e.g. a context.Done cleanup path)
+// forever.
+func TestTypedChanCloseDoesNotDeadlockOnStalledBlockSend(t *testing.T) {
+	tc := newTypedChan[int](TypedSubscribeOpts{BufferSize: 1, DropPolicy: Block})
+
+	// Fill the buffer, then start a second send that must block since
+	// nothing is draining the channel.
+	tc.send(1)
+	sendReturned := make(chan struct{})
+	go func() {
+		tc.send(2)
+		close(sendReturned)
+	}()
+
+	closeReturned := make(chan struct{})
+	go func() {
+		tc.close()
+		close(closeReturned)
+	}()
+
+	select {
+	case <-closeReturned:
+	case <-time.After(time.Second):
+		t.Fatal("close() deadlocked on a stalled Block-policy send")
+	}
+
+	select {
+	case <-sendReturned:
+	case <-time.After(time.Second):
+		t.Fatal("send() never returned after close()")
+	}
+}
+
+func TestTypedChanSendAfterCloseIsNoop(t *testing.T) {
+	tc := newTypedChan[int](TypedSubscribeOpts{BufferSize: 1, DropPolicy: Block})
+	tc.close()
+
+	assert.NotPanics(t, func() { tc.send(1) })
+
+	_, ok := <-tc.ch
+	assert.False(t, ok, "ch should be closed")
+}
+
+func TestTypedChanDropOldestDiscardsOldestOnOverflow(t *testing.T) {
+	tc := newTypedChan[int](TypedSubscribeOpts{BufferSize: 1, DropPolicy: DropOldest})
+	tc.send(1)
+	tc.send(2)
+
+	v, ok := <-tc.ch
+	require.True(t, ok)
+	assert.Equal(t, 2, v)
+}
@@ -71,6 +71,11 @@ type (
 		Notification string `json:"notification"`
 	}
 
+	SubscriptionResponse struct {
+		Method       string         `json:"method"`
+		Subscription map[string]any `json:"subscription"`
+	}
+
 	//easyjson:skip
 	WebData2 struct {
 		ClearinghouseState     *ClearinghouseState `json:"clearinghouseState,omitempty"`
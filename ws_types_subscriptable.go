@@ -88,3 +88,7 @@ func (w WebData3) Key() string {
 	// The dispatching is handled by the subscription system based on the subscription key.
 	return ChannelWebData3
 }
+
+func (w WsTwapFills) Key() string {
+	return key(ChannelTwapFills, w.User)
+}